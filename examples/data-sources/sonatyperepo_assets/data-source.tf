@@ -0,0 +1,12 @@
+data "sonatyperepo_assets" "binary" {
+  repository = "raw-hosted"
+  name       = "tools/my-binary"
+}
+
+output "binary_sha256" {
+  value = data.sonatyperepo_assets.binary.assets[0].sha256
+}
+
+output "binary_download_url" {
+  value = data.sonatyperepo_assets.binary.assets[0].download_url
+}
@@ -0,0 +1,7 @@
+data "sonatyperepo_blob_store_gcs" "example" {
+  name = "gcs-blob-store"
+}
+
+output "gcs_blob_store" {
+  value = data.sonatyperepo_blob_store_gcs.example
+}
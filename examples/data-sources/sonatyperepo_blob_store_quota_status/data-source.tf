@@ -0,0 +1,19 @@
+data "sonatyperepo_blob_store_quota_status" "example" {
+  name = "default"
+}
+
+output "default_blob_store_quota_violated" {
+  value = data.sonatyperepo_blob_store_quota_status.example.is_violation
+}
+
+# Fail a plan or apply when the Soft Quota of a Blob Store is violated
+check "default_blob_store_quota" {
+  data "sonatyperepo_blob_store_quota_status" "default" {
+    name = "default"
+  }
+
+  assert {
+    condition     = !data.sonatyperepo_blob_store_quota_status.default.is_violation
+    error_message = "Blob Store 'default' is in violation of its Soft Quota: ${data.sonatyperepo_blob_store_quota_status.default.message}"
+  }
+}
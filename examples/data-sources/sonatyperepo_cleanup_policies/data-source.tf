@@ -0,0 +1,10 @@
+data "sonatyperepo_cleanup_policies" "all" {}
+
+# Only Cleanup Policies for Maven Repositories
+data "sonatyperepo_cleanup_policies" "maven" {
+  format = "maven2"
+}
+
+output "maven_cleanup_policy_names" {
+  value = [for p in data.sonatyperepo_cleanup_policies.maven.cleanup_policies : p.name]
+}
@@ -0,0 +1,7 @@
+data "sonatyperepo_cleanup_policy" "weekly" {
+  name = "weekly-cleanup"
+}
+
+output "weekly_cleanup_criteria" {
+  value = data.sonatyperepo_cleanup_policy.weekly.criteria
+}
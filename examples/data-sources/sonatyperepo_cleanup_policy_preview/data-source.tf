@@ -0,0 +1,13 @@
+# Preview what an aggressive cleanup policy would delete before applying it
+data "sonatyperepo_cleanup_policy_preview" "maven_releases" {
+  repository_name = "maven-releases"
+
+  criteria = {
+    last_downloaded = 30
+    release_type    = "RELEASES"
+  }
+}
+
+output "components_to_be_deleted" {
+  value = data.sonatyperepo_cleanup_policy_preview.maven_releases.component_count
+}
@@ -0,0 +1,11 @@
+data "sonatyperepo_components" "chart" {
+  repository = "helm-hosted"
+  name       = "my-chart"
+  prerelease = false
+  sort       = "version"
+  direction  = "desc"
+}
+
+output "latest_chart_version" {
+  value = data.sonatyperepo_components.chart.components[0].version
+}
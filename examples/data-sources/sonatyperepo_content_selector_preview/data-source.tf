@@ -0,0 +1,18 @@
+# Preview an expression against every raw Repository
+data "sonatyperepo_content_selector_preview" "team_a" {
+  expression = "format == \"raw\" and path =^ \"/team-a/\""
+  format     = "raw"
+}
+
+# Preview an existing Content Selector against a single Repository
+data "sonatyperepo_content_selector_preview" "releases" {
+  content_selector = "maven-releases-only"
+  repository       = "maven-public"
+}
+
+check "team_a_content_selector" {
+  assert {
+    condition     = alltrue([for p in data.sonatyperepo_content_selector_preview.team_a.paths : startswith(p, "/team-a/")])
+    error_message = "Content Selector matches Assets outside of /team-a/"
+  }
+}
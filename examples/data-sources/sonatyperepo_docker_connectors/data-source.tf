@@ -0,0 +1,7 @@
+data "sonatyperepo_docker_connectors" "all" {}
+
+output "docker_ports_in_use" {
+  value = compact(flatten([
+    for c in data.sonatyperepo_docker_connectors.all.connectors : [c.http_port, c.https_port]
+  ]))
+}
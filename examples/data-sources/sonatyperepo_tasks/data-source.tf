@@ -3,3 +3,12 @@ data "sonatyperepo_tasks" "all" {}
 output "tasks" {
   value = data.sonatyperepo_tasks.all.tasks
 }
+
+# Blob Store Compact Tasks whose last run did not succeed
+data "sonatyperepo_tasks" "compact" {
+  type = "blobstore.compact"
+}
+
+output "failed_compact_tasks" {
+  value = [for t in data.sonatyperepo_tasks.compact.tasks : t.name if t.last_run_result != null && t.last_run_result != "OK"]
+}
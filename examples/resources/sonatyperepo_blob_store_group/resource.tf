@@ -14,3 +14,24 @@ resource "sonatyperepo_blob_store_group" "example_group" {
     sonatyperepo_blob_store.test2.name,
   ]
 }
+
+// Promote the existing File Blob Store "example-full" to a Group of the same name, without
+// destroying it or the Repositories using it. The File Blob Store is renamed to "example-full-1"
+// and becomes the first member of the Group - stop managing it under its old name first.
+removed {
+  from = sonatyperepo_blob_store_file.example_full
+
+  lifecycle {
+    destroy = false
+  }
+}
+
+resource "sonatyperepo_blob_store_group" "example_promoted" {
+  name                = "example-full"
+  fill_policy         = "writeToFirst"
+  original_renamed_to = "example-full-1"
+  members = [
+    "example-full-1",
+    sonatyperepo_blob_store_file.example_overflow.name,
+  ]
+}
@@ -23,3 +23,23 @@ resource "sonatyperepo_blob_store_s3" "s3_blob_store" {
     chunked_encoding  = false
   }
 }
+
+resource "sonatyperepo_blob_store_s3" "s3_blob_store_with_failover" {
+  name = "s3-blob-store-with-failover"
+
+  bucket_configuration = {
+    bucket = {
+      name   = "my-nexus-bucket"
+      prefix = "nexus"
+      region = "us-east-1"
+    }
+
+    # Requires Sonatype Nexus Repository Manager 3.74.0 PRO or later
+    failover_buckets = [
+      {
+        region      = "us-west-2"
+        bucket_name = "my-nexus-bucket-replica"
+      }
+    ]
+  }
+}
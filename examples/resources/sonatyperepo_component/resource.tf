@@ -0,0 +1,28 @@
+resource "sonatyperepo_component" "raw_file" {
+  repository = "raw-hosted"
+  format     = "raw"
+  file       = "${path.module}/files/setup.sh"
+  raw = {
+    directory = "scripts"
+    filename  = "setup.sh"
+  }
+}
+
+resource "sonatyperepo_component" "maven_jar" {
+  repository = "maven-releases"
+  format     = "maven2"
+  file       = "${path.module}/build/example-1.0.0.jar"
+  maven2 = {
+    group_id     = "com.example"
+    artifact_id  = "example"
+    version      = "1.0.0"
+    extension    = "jar"
+    generate_pom = true
+  }
+}
+
+resource "sonatyperepo_component" "npm_package" {
+  repository = "npm-hosted"
+  format     = "npm"
+  file       = "${path.module}/dist/example-1.0.0.tgz"
+}
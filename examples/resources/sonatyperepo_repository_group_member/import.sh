@@ -0,0 +1,6 @@
+# An existing member of a Group Repository can be imported as follows.
+#
+# NOTE: The Identifier is the Group Repository name and member Repository name, separated by a comma.
+
+# Example
+terraform import sonatyperepo_repository_group_member.team_releases maven-public,team-a-releases
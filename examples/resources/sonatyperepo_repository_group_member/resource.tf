@@ -0,0 +1,30 @@
+# The Group Repository is owned elsewhere - for example, by a platform team
+resource "sonatyperepo_repository_maven_group" "maven_public" {
+  name   = "maven-public"
+  online = true
+  storage = {
+    blob_store_name                = "default"
+    strict_content_type_validation = true
+  }
+  group = {
+    member_names = ["maven-central"]
+  }
+
+  # Members are managed by sonatyperepo_repository_group_member resources
+  lifecycle {
+    ignore_changes = [group.member_names]
+  }
+}
+
+# Append a member to the end of the Group Repository
+resource "sonatyperepo_repository_group_member" "team_releases" {
+  group_name  = "maven-public"
+  member_name = "team-a-releases"
+}
+
+# Add a member as the first member of the Group Repository
+resource "sonatyperepo_repository_group_member" "team_snapshots" {
+  group_name  = "maven-public"
+  member_name = "team-a-snapshots"
+  position    = 0
+}
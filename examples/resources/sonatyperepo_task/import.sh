@@ -0,0 +1,9 @@
+# Import an existing Task of any type into Terraform State.
+
+# Example
+terraform import sonatyperepo_task.rebuild_npm_metadata TASK_ID
+
+# Note: `frequency` is imported from Sonatype Nexus Repository, including any
+# defaults it filled in (such as `start_date`). `properties` cannot be imported
+# for a Task of any type, so the first `terraform apply` after import re-asserts
+# them in Nexus to match your HCL.
@@ -0,0 +1,12 @@
+resource "sonatyperepo_task" "rebuild_npm_metadata" {
+  name                   = "rebuild-npm-hosted-metadata"
+  type                   = "repository.npm.rebuild-metadata"
+  enabled                = true
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    repositoryName = "npm-hosted"
+  }
+}
@@ -0,0 +1,15 @@
+resource "sonatyperepo_task_blobstore_delete_temp_files" "blobstore_delete_temp_files" {
+  name                   = "blobstore-delete-temp-files-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    blob_store_name = "default"
+  }
+}
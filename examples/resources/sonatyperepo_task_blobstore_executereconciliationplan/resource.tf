@@ -0,0 +1,15 @@
+resource "sonatyperepo_task_blobstore_executereconciliationplan" "blobstore_executereconciliationplan" {
+  name                   = "blobstore-executereconciliationplan-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    blob_store_name = "default"
+  }
+}
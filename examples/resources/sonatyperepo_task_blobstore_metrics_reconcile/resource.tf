@@ -0,0 +1,15 @@
+resource "sonatyperepo_task_blobstore_metrics_reconcile" "blobstore_metrics_reconcile" {
+  name                   = "blobstore-metrics-reconcile-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    blob_store_name = "default"
+  }
+}
@@ -0,0 +1,16 @@
+resource "sonatyperepo_task_blobstore_planreconciliation" "blobstore_planreconciliation" {
+  name                   = "blobstore-planreconciliation-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    blob_store_name = "default"
+    since_days      = 7
+  }
+}
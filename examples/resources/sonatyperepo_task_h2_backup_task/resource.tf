@@ -0,0 +1,15 @@
+resource "sonatyperepo_task_h2_backup_task" "h2_backup" {
+  name                   = "h2-backup-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    location = "backup"
+  }
+}
@@ -3,7 +3,6 @@
 # Example
 terraform import sonatyperepo_task_repository_docker_upload_purge.task TASK_ID
 
-# Note: the public REST API does not return `properties` or full `frequency` for
-# a Task, so the next `terraform plan` will show those fields as a diff against
-# your configuration. The first `terraform apply` after import re-asserts them
-# in Nexus to match your HCL.
+# Note: `frequency` and `properties` are imported from Sonatype Nexus Repository,
+# including any defaults it filled in (such as `start_date`) - review the first
+# `terraform plan` after import for differences from your configuration.
@@ -0,0 +1,16 @@
+resource "sonatyperepo_task_repository_export" "export" {
+  name                   = "export-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    repository_name = "npm-hosted-repo"
+    file_path       = "/var/exports/npm-hosted-repo"
+  }
+}
@@ -0,0 +1,8 @@
+# Import an existing 'repository.helm.rebuild.metadata' Task into Terraform State.
+
+# Example
+terraform import sonatyperepo_task_repository_helm_rebuild_metadata.task TASK_ID
+
+# Note: `frequency` and `properties` are imported from Sonatype Nexus Repository,
+# including any defaults it filled in (such as `start_date`) - review the first
+# `terraform plan` after import for differences from your configuration.
@@ -0,0 +1,15 @@
+resource "sonatyperepo_task_repository_helm_rebuild_metadata" "helm_rebuild_metadata" {
+  name                   = "helm-rebuild-metadata-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    repository_name = "helm-hosted-repo"
+  }
+}
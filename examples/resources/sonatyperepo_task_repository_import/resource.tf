@@ -0,0 +1,19 @@
+resource "sonatyperepo_task_repository_import" "import" {
+  name                   = "import-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    repository_name     = "npm-hosted-repo"
+    file_path           = "/var/exports/npm-hosted-repo"
+    hard_links_enabled  = true
+    delete_source_files = false
+    dry_run             = false
+  }
+}
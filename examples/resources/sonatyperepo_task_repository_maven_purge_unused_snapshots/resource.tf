@@ -0,0 +1,16 @@
+resource "sonatyperepo_task_repository_maven_purge_unused_snapshots" "maven_purge_unused_snapshots" {
+  name                   = "maven-purge-unused-snapshots-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    repository_name = "maven-snapshots-repo"
+    last_used       = 14
+  }
+}
@@ -0,0 +1,18 @@
+resource "sonatyperepo_task_repository_maven_rebuild_metadata" "maven_rebuild_metadata" {
+  name                   = "maven-rebuild-metadata-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    repository_name   = "maven-hosted-repo"
+    group_id          = "org.apache.commons"
+    artifact_id       = "commons-lang3"
+    rebuild_checksums = true
+  }
+}
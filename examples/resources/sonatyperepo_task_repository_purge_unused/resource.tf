@@ -0,0 +1,16 @@
+resource "sonatyperepo_task_repository_purge_unused" "purge_unused" {
+  name                   = "purge-unused-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    repository_name = "maven-proxy-repo"
+    last_used       = 30
+  }
+}
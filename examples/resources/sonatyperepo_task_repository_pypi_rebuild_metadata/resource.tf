@@ -0,0 +1,15 @@
+resource "sonatyperepo_task_repository_pypi_rebuild_metadata" "pypi_rebuild_metadata" {
+  name                   = "pypi-rebuild-metadata-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    repository_name = "pypi-hosted-repo"
+  }
+}
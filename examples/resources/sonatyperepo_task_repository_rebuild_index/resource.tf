@@ -0,0 +1,15 @@
+resource "sonatyperepo_task_repository_rebuild_index" "rebuild_index" {
+  name                   = "rebuild-index-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    repository_name = "*"
+  }
+}
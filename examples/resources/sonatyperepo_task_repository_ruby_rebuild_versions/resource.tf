@@ -0,0 +1,15 @@
+resource "sonatyperepo_task_repository_ruby_rebuild_versions" "ruby_rebuild_versions" {
+  name                   = "ruby-rebuild-versions-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    repository_name = "rubygems-hosted-repo"
+  }
+}
@@ -0,0 +1,8 @@
+# Import an existing 'security.purge-api-keys' Task into Terraform State.
+
+# Example
+terraform import sonatyperepo_task_security_purge_api_keys.task TASK_ID
+
+# Note: `frequency` is imported from Sonatype Nexus Repository, including any
+# defaults it filled in (such as `start_date`) - review the first
+# `terraform plan` after import for differences from your configuration.
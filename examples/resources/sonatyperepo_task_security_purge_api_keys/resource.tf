@@ -0,0 +1,11 @@
+resource "sonatyperepo_task_security_purge_api_keys" "security_purge_api_keys" {
+  name                   = "security-purge-api-keys-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+}
@@ -0,0 +1,18 @@
+resource "sonatyperepo_task_tags_cleanup" "tags_cleanup" {
+  name                   = "tags-cleanup-task"
+  enabled                = true
+  alert_email            = "admin@example.com"
+  notification_condition = "FAILURE"
+
+  frequency = {
+    schedule       = "weekly"
+    recurring_days = [1] # Monday
+  }
+
+  properties = {
+    repository_name              = "*"
+    older_than                   = 90
+    tag_name_pattern             = "build-.*"
+    delete_associated_components = false
+  }
+}
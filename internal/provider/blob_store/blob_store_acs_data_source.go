@@ -56,13 +56,8 @@ func (d *acsBlobStoreDataSource) Metadata(_ context.Context, req datasource.Meta
 func (d *acsBlobStoreDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = tfschema.Schema{
 		Description: "Use this data source to get a specific S3 Blob Store by it's name",
-		Attributes: map[string]tfschema.Attribute{
+		Attributes: blobStoreDataSourceAttributes(common.BLOB_STORE_TYPE_AZURE, map[string]tfschema.Attribute{
 			"name": schema.DataSourceRequiredString("Name of the Blob Store"),
-			// "type": schema.DataSourceOptionalString(fmt.Sprintf("Type of this Blob Store - will always be '%s'", common.BLOB_STORE_TYPE_S3)),
-			"soft_quota": schema.DataSourceComputedOptionalSingleNestedAttribute("Soft Quota for this Blob Store", map[string]tfschema.Attribute{
-				"type":  schema.DataSourceOptionalString("Soft Quota type"),
-				"limit": schema.DataSourceOptionalInt64("Quota limit"),
-			}),
 			"bucket_configuration": schema.DataSourceComputedSingleNestedAttribute("Bucket Configuration for this Blob Store", map[string]tfschema.Attribute{
 				"account_name":   schema.DataSourceComputedString("Account name found under Access keys for the storage account."),
 				"container_name": schema.DataSourceComputedString("The name of an existing container to be used for storage."),
@@ -71,7 +66,7 @@ func (d *acsBlobStoreDataSource) Schema(_ context.Context, req datasource.Schema
 					"account_key":           schema.DataSourceComputedString("The account key"),
 				}),
 			}),
-		},
+		}),
 	}
 }
 
@@ -114,6 +109,10 @@ func (d *acsBlobStoreDataSource) Read(ctx context.Context, req datasource.ReadRe
 	} else {
 		// Update State
 		data.MapFromApi(apiResponse)
+		readBlobStoreUsage(d.AuthContext(ctx), d.Services, data.Name.ValueString(), common.BLOB_STORE_TYPE_AZURE, data.IncludeRepositories, &data.BlobStoreCommonModelDS, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		if resp.Diagnostics.HasError() {
 			return
@@ -245,7 +245,7 @@ func (r *blobStoreAcsResource) Delete(ctx context.Context, req resource.DeleteRe
 	ctx = r.AuthContext(ctx)
 
 	// Delete API Call
-	DeleteBlobStore(r.Client, &ctx, state.Name.ValueString(), resp)
+	DeleteBlobStore(r.Client, r.Services, &ctx, state.Name.ValueString(), resp)
 }
 
 // This allows users to import existing S3 Blob Stores into Terraform state.
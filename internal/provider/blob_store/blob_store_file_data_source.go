@@ -56,17 +56,10 @@ func (d *fileBlobStoreDataSource) Metadata(_ context.Context, req datasource.Met
 func (d *fileBlobStoreDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = tfschema.Schema{
 		Description: "Use this data source to get a specific File Blob Store by it's name",
-		Attributes: map[string]tfschema.Attribute{
+		Attributes: blobStoreDataSourceAttributes(common.BLOB_STORE_TYPE_FILE, map[string]tfschema.Attribute{
 			"name": schema.DataSourceRequiredString("Name of the Blob Store"),
 			"path": schema.DataSourceComputedString("The Path on disk of this File Blob Store"),
-			"soft_quota": schema.DataSourceOptionalSingleNestedAttribute(
-				"Soft Quota for this Blob Store",
-				map[string]tfschema.Attribute{
-					"type":  schema.DataSourceComputedString("Soft Quota type"),
-					"limit": schema.DataSourceComputedInt64("Quota limit"),
-				},
-			),
-		},
+		}),
 	}
 }
 
@@ -99,12 +92,9 @@ func (d *fileBlobStoreDataSource) Read(ctx context.Context, req datasource.ReadR
 		Path: types.StringValue(*blobStore.Path),
 	}
 
-	if blobStore.SoftQuota != nil && blobStore.SoftQuota.Type != nil {
-		tflog.Debug(ctx, fmt.Sprintf("%v", blobStore.SoftQuota))
-		state.SoftQuota = &model.BlobStoreSoftQuota{
-			Type:  types.StringValue(*blobStore.SoftQuota.Type),
-			Limit: types.Int64Value(*blobStore.SoftQuota.Limit),
-		}
+	readBlobStoreUsage(ctx, d.Services, data.Name.ValueString(), common.BLOB_STORE_TYPE_FILE, data.IncludeRepositories, &state.BlobStoreCommonModelDS, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
 	// Set state
@@ -46,11 +46,17 @@ func TestAccBlobStoreFileDataSource(t *testing.T) {
 			// Test 2: Happy path - default blob store exists and is readable
 			{
 				Config: utils_test.ProviderConfig + `data "sonatyperepo_blob_store_file" "b" {
-					name = "default"
+					name                 = "default"
+					include_repositories = true
 				}`,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(dataSourceBlobStoreFile, "name", "default"),
 					resource.TestCheckResourceAttr(dataSourceBlobStoreFile, "path", expectedPath),
+					resource.TestCheckResourceAttr(dataSourceBlobStoreFile, "type", common.BLOB_STORE_TYPE_FILE),
+					resource.TestCheckResourceAttr(dataSourceBlobStoreFile, "unavailable", "false"),
+					resource.TestCheckResourceAttrSet(dataSourceBlobStoreFile, "blob_count"),
+					resource.TestCheckResourceAttrSet(dataSourceBlobStoreFile, "total_size_in_bytes"),
+					resource.TestCheckResourceAttrSet(dataSourceBlobStoreFile, "repositories.#"),
 					// Soft quota is absent in default config
 					resource.TestCheckNoResourceAttr(dataSourceBlobStoreFile, "soft_quota"),
 				),
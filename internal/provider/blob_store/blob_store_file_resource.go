@@ -258,7 +258,7 @@ func (r *blobStoreFileResource) Delete(ctx context.Context, req resource.DeleteR
 	ctx = r.AuthContext(ctx)
 
 	// Delete API Call
-	DeleteBlobStore(r.Client, &ctx, state.Name.ValueString(), resp)
+	DeleteBlobStore(r.Client, r.Services, &ctx, state.Name.ValueString(), resp)
 }
 
 // This allows users to import existing File Blob Stores into Terraform state.
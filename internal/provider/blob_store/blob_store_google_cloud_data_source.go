@@ -0,0 +1,110 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blob_store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &googleCloudBlobStoreDataSource{}
+	_ datasource.DataSourceWithConfigure = &googleCloudBlobStoreDataSource{}
+)
+
+// BlobStoreGoogleCloudDataSource is a helper function to simplify the provider implementation.
+func BlobStoreGoogleCloudDataSource() datasource.DataSource {
+	return &googleCloudBlobStoreDataSource{}
+}
+
+// googleCloudBlobStoreDataSource is the data source implementation.
+type googleCloudBlobStoreDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *googleCloudBlobStoreDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blob_store_gcs"
+}
+
+// Schema defines the schema for the data source.
+func (d *googleCloudBlobStoreDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get a specific Google Cloud Storage Blob Store by it's name",
+		Attributes: blobStoreDataSourceAttributes(common.BLOB_STORE_TYPE_GOOGLE_CLOUD, map[string]tfschema.Attribute{
+			"name": schema.DataSourceRequiredString("Name of the Blob Store"),
+			"bucket_configuration": schema.DataSourceComputedSingleNestedAttribute("Bucket Configuration for this Blob Store", map[string]tfschema.Attribute{
+				"bucket": schema.DataSourceComputedSingleNestedAttribute("Main Bucket Configuration for this Blob Store", map[string]tfschema.Attribute{
+					"name":       schema.DataSourceComputedString("The name of the Google Cloud Storage bucket"),
+					"prefix":     schema.DataSourceComputedString("The path within your Cloud Storage bucket where blob data is stored"),
+					"region":     schema.DataSourceComputedString("The Google Cloud region for the bucket"),
+					"project_id": schema.DataSourceComputedString("The Google Cloud project id for the bucket"),
+				}),
+				"authentication": schema.DataSourceComputedSingleNestedAttribute("Authentication Configuration for Google Cloud Storage", map[string]tfschema.Attribute{
+					"authentication_method": schema.DataSourceComputedString("The type of Google Cloud authentication used"),
+				}),
+				"encryption": schema.DataSourceComputedSingleNestedAttribute("Encryption Configuration for Google Cloud Storage", map[string]tfschema.Attribute{
+					"encryption_type": schema.DataSourceComputedString("The type of GCP server side encryption used"),
+					"encryption_key":  schema.DataSourceComputedString("CryptoKey ID for KMS encryption"),
+				}),
+			}),
+		}),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *googleCloudBlobStoreDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.BlobStoreGoogleCloudModelDS
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	ctx = d.AuthContext(ctx)
+
+	apiResponse, httpResponse, err := d.Services.BlobStore.GetBlobStore2(ctx, data.Name.ValueString())
+	if err != nil {
+		errors.HandleAPIError(
+			fmt.Sprintf("No Google Cloud Storage Blob Store with name: %s", data.Name.ValueString()),
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	data.MapFromApi(apiResponse)
+	readBlobStoreUsage(ctx, d.Services, data.Name.ValueString(), common.BLOB_STORE_TYPE_GOOGLE_CLOUD, data.IncludeRepositories, &data.BlobStoreCommonModelDS, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
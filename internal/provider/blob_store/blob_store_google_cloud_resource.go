@@ -276,7 +276,7 @@ func (r *blobStoreGoogleCloudResource) Delete(ctx context.Context, req resource.
 	}
 
 	ctx = r.AuthContext(ctx)
-	DeleteBlobStore(r.Client, &ctx, state.Name.ValueString(), resp)
+	DeleteBlobStore(r.Client, r.Services, &ctx, state.Name.ValueString(), resp)
 }
 
 // ImportState implements the import functionality for the Google Cloud Storage Blob Store resource.
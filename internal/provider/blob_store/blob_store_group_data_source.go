@@ -56,18 +56,11 @@ func (d *groupBlobStoreDataSource) Metadata(_ context.Context, req datasource.Me
 func (d *groupBlobStoreDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = tfschema.Schema{
 		Description: "Use this data source to get a specific File Blob Store by it's name",
-		Attributes: map[string]tfschema.Attribute{
+		Attributes: blobStoreDataSourceAttributes(common.BLOB_STORE_TYPE_GROUP, map[string]tfschema.Attribute{
 			"name":        schema.DataSourceRequiredString("Name of the Blob Store Group"),
 			"fill_policy": schema.DataSourceComputedString("Defines how writes are made to the member Blob Stores"),
 			"members":     schema.ResourceComputedStringSet("Set of the names of blob stores that are members of this group"),
-			"soft_quota": schema.DataSourceComputedSingleNestedAttribute(
-				"Soft Quota for this Blob Store",
-				map[string]tfschema.Attribute{
-					"type":  schema.DataSourceComputedString("Soft Quota type"),
-					"limit": schema.DataSourceComputedInt64("Quota limit"),
-				},
-			),
-		},
+		}),
 	}
 }
 
@@ -104,13 +97,6 @@ func (d *groupBlobStoreDataSource) Read(ctx context.Context, req datasource.Read
 		Name: types.StringValue(data.Name.ValueString()),
 	}
 
-	if apiResponse.SoftQuota != nil && apiResponse.SoftQuota.Type != nil {
-		tflog.Debug(ctx, fmt.Sprintf("%v", apiResponse.SoftQuota))
-		state.SoftQuota = &model.BlobStoreSoftQuota{
-			Type:  types.StringValue(*apiResponse.SoftQuota.Type),
-			Limit: types.Int64Value(*apiResponse.SoftQuota.Limit),
-		}
-	}
 	if len(apiResponse.Members) > 0 {
 		for _, m := range apiResponse.Members {
 			state.Members = append(state.Members, types.StringValue(m))
@@ -120,6 +106,11 @@ func (d *groupBlobStoreDataSource) Read(ctx context.Context, req datasource.Read
 		state.FillPolicy = types.StringValue(*apiResponse.FillPolicy)
 	}
 
+	readBlobStoreUsage(ctx, d.Services, data.Name.ValueString(), common.BLOB_STORE_TYPE_GROUP, data.IncludeRepositories, &state.BlobStoreCommonModelDS, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Set state
 	diags := resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
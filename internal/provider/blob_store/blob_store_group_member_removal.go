@@ -0,0 +1,153 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blob_store
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+)
+
+const (
+	// Properties of the blobstore.group.memberRemoval Task
+	memberRemovalPropertyGroupName  = "blobstoreName"
+	memberRemovalPropertyMemberName = "memberName"
+)
+
+var (
+	// How often, and for how long, to wait for a member removal Task to complete
+	memberRemovalPollInterval = 5 * time.Second
+	memberRemovalTimeout      = 60 * time.Minute
+)
+
+// removedGroupMembers returns the members in state that are no longer planned.
+func removedGroupMembers(stateMembers []string, planMembers []string) []string {
+	planned := make(map[string]bool, len(planMembers))
+	for _, m := range planMembers {
+		planned[m] = true
+	}
+	removed := make([]string, 0)
+	for _, m := range stateMembers {
+		if !planned[m] {
+			removed = append(removed, m)
+		}
+	}
+	return removed
+}
+
+// removeGroupMember runs the blobstore.group.memberRemoval Task to migrate blobs off a member of a
+// Blob Store Group and remove it from the Group, waiting for the Task to complete. The Task is
+// deleted afterwards.
+func removeGroupMember(ctx context.Context, taskService common.TaskService, groupName string, memberName string, resp *resource.UpdateResponse) {
+	task, httpResponse, err := taskService.CreateTask(ctx, &common.TaskCreateApiModel{
+		Name:    fmt.Sprintf("Remove member %s from Blob Store Group %s", memberName, groupName),
+		Enabled: true,
+		Frequency: common.TaskFrequencyApiModel{
+			Schedule: common.FREQUENCY_SCHEDULE_MANUAL,
+		},
+		NotificationCondition: common.NOTIFICATION_CONDITION_FAILURE,
+		Type:                  common.TASK_TYPE_BLOBSTORE_GROUP_MEMBERREMOVAL.String(),
+		Properties: &map[string]string{
+			memberRemovalPropertyGroupName:  groupName,
+			memberRemovalPropertyMemberName: memberName,
+		},
+	})
+	if err != nil {
+		errors.HandleAPIError(
+			fmt.Sprintf("Error creating Task to remove member '%s' from Blobstore Group", memberName),
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+	defer func() {
+		if httpResponse, err := taskService.DeleteTaskById(ctx, *task.Id); err != nil {
+			errors.HandleAPIWarning(
+				fmt.Sprintf("Unable to delete Task that removed member '%s' from Blobstore Group", memberName),
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+		}
+	}()
+
+	httpResponse, err = taskService.RunTask(ctx, *task.Id)
+	if err != nil {
+		errors.HandleAPIError(
+			fmt.Sprintf("Error running Task to remove member '%s' from Blobstore Group", memberName),
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Waiting for member '%s' to be removed from Blob Store Group '%s'", memberName, groupName))
+	deadline := time.Now().Add(memberRemovalTimeout)
+	for {
+		task, httpResponse, err = taskService.GetTaskById(ctx, *task.Id)
+		if err != nil {
+			errors.HandleAPIError(
+				fmt.Sprintf("Error reading Task removing member '%s' from Blobstore Group", memberName),
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+			return
+		}
+
+		// A newly created Task has no run result until its first run completes
+		if task.LastRunResult != nil && (task.CurrentState == nil || *task.CurrentState != common.TASK_CURRENT_STATE_RUNNING) {
+			if *task.LastRunResult != common.TASK_LAST_RUN_RESULT_OK {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("members"),
+					"Removal of Blobstore Group member was not successful",
+					fmt.Sprintf("Task removing member '%s' from Blob Store Group '%s' finished with result '%s' - the member has not been removed. Review the Sonatype Nexus Repository logs.", memberName, groupName, *task.LastRunResult),
+				)
+			}
+			return
+		}
+
+		if time.Now().After(deadline) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("members"),
+				"Removal of Blobstore Group member did not complete",
+				fmt.Sprintf("Task removing member '%s' from Blob Store Group '%s' did not complete within %s", memberName, groupName, memberRemovalTimeout),
+			)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			resp.Diagnostics.AddAttributeError(
+				path.Root("members"),
+				"Removal of Blobstore Group member was interrupted",
+				fmt.Sprintf("Stopped waiting for member '%s' to be removed from Blob Store Group '%s': %v", memberName, groupName, ctx.Err()),
+			)
+			return
+		case <-time.After(memberRemovalPollInterval):
+		}
+	}
+}
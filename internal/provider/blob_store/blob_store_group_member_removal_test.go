@@ -0,0 +1,123 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blob_store
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+// scriptedTaskService reports each of the scripted Task states in turn, one per read
+type scriptedTaskService struct {
+	common.TaskService
+	states  []common.TaskApiModel
+	created *common.TaskCreateApiModel
+	ran     bool
+	deleted bool
+}
+
+func (s *scriptedTaskService) CreateTask(_ context.Context, plan *common.TaskCreateApiModel) (*common.TaskApiModel, *http.Response, error) {
+	s.created = plan
+	return &common.TaskApiModel{Id: common.StringPointer("task-id")}, &http.Response{StatusCode: http.StatusCreated}, nil
+}
+
+func (s *scriptedTaskService) RunTask(_ context.Context, _ string) (*http.Response, error) {
+	s.ran = true
+	return &http.Response{StatusCode: http.StatusNoContent}, nil
+}
+
+func (s *scriptedTaskService) GetTaskById(_ context.Context, id string) (*common.TaskApiModel, *http.Response, error) {
+	state := s.states[0]
+	if len(s.states) > 1 {
+		s.states = s.states[1:]
+	}
+	state.Id = common.StringPointer(id)
+	return &state, &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func (s *scriptedTaskService) DeleteTaskById(_ context.Context, _ string) (*http.Response, error) {
+	s.deleted = true
+	return &http.Response{StatusCode: http.StatusNoContent}, nil
+}
+
+func TestRemovedGroupMembers(t *testing.T) {
+	assert.Equal(t, []string{"b", "d"}, removedGroupMembers([]string{"a", "b", "c", "d"}, []string{"a", "c", "e"}))
+	assert.Empty(t, removedGroupMembers([]string{"a"}, []string{"a", "b"}))
+}
+
+func TestRemoveGroupMemberWaitsForTask(t *testing.T) {
+	memberRemovalPollInterval = time.Millisecond
+	taskService := &scriptedTaskService{
+		states: []common.TaskApiModel{
+			{CurrentState: common.StringPointer("WAITING")},
+			{CurrentState: common.StringPointer(common.TASK_CURRENT_STATE_RUNNING)},
+			{CurrentState: common.StringPointer("WAITING"), LastRunResult: common.StringPointer(common.TASK_LAST_RUN_RESULT_OK)},
+		},
+	}
+	resp := resource.UpdateResponse{}
+
+	removeGroupMember(context.Background(), taskService, "group", "member", &resp)
+
+	assert.False(t, resp.Diagnostics.HasError())
+	assert.Equal(t, common.TASK_TYPE_BLOBSTORE_GROUP_MEMBERREMOVAL.String(), taskService.created.Type)
+	assert.Equal(t, "group", (*taskService.created.Properties)[memberRemovalPropertyGroupName])
+	assert.Equal(t, "member", (*taskService.created.Properties)[memberRemovalPropertyMemberName])
+	assert.True(t, taskService.ran)
+	assert.True(t, taskService.deleted)
+	assert.Len(t, taskService.states, 1)
+}
+
+func TestRemoveGroupMemberTaskFailed(t *testing.T) {
+	memberRemovalPollInterval = time.Millisecond
+	taskService := &scriptedTaskService{
+		states: []common.TaskApiModel{
+			{CurrentState: common.StringPointer("WAITING"), LastRunResult: common.StringPointer("FAILED")},
+		},
+	}
+	resp := resource.UpdateResponse{}
+
+	removeGroupMember(context.Background(), taskService, "group", "member", &resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Detail(), "finished with result 'FAILED'")
+	assert.True(t, taskService.deleted)
+}
+
+func TestRemoveGroupMemberTimeout(t *testing.T) {
+	memberRemovalPollInterval = time.Millisecond
+	memberRemovalTimeout = 5 * time.Millisecond
+	defer func() { memberRemovalTimeout = 60 * time.Minute }()
+	taskService := &scriptedTaskService{
+		states: []common.TaskApiModel{
+			{CurrentState: common.StringPointer(common.TASK_CURRENT_STATE_RUNNING)},
+		},
+	}
+	resp := resource.UpdateResponse{}
+
+	removeGroupMember(context.Background(), taskService, "group", "member", &resp)
+
+	assert.True(t, resp.Diagnostics.HasError())
+	assert.Contains(t, resp.Diagnostics.Errors()[0].Summary(), "did not complete")
+	assert.True(t, taskService.deleted)
+}
@@ -20,11 +20,13 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"terraform-provider-sonatyperepo/internal/provider/common"
 	"terraform-provider-sonatyperepo/internal/provider/model"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -35,6 +37,12 @@ import (
 	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
 )
 
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &blobStoreGroupResource{}
+	_ resource.ResourceWithModifyPlan = &blobStoreGroupResource{}
+)
+
 // blobStoreGroupResource is the resource implementation.
 type blobStoreGroupResource struct {
 	common.BaseResource
@@ -54,8 +62,8 @@ func (r *blobStoreGroupResource) Metadata(_ context.Context, req resource.Metada
 func (r *blobStoreGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = tfschema.Schema{
 		Description: `Manage Blob Store Groups.
-		
-This resource does not support promoting a Blob Store to becoming a Group - see examples.`,
+
+An existing Blob Store can be promoted to a Group of the same name by setting ` + "`original_renamed_to`" + ` - see examples.`,
 		Attributes: map[string]tfschema.Attribute{
 			"name": schema.ResourceRequiredString("Name of the Blob Store"),
 			"soft_quota": schema.ResourceOptionalSingleNestedAttribute(
@@ -65,17 +73,127 @@ This resource does not support promoting a Blob Store to becoming a Group - see
 					"limit": schema.ResourceOptionalInt64("Quota limit"),
 				},
 			),
-			"members": schema.ResourceRequiredStringList("List of the names of blob stores that are members of this group"),
+			"members": schema.ResourceRequiredStringList("List of the names of blob stores that are members of this group. Removed members first have their blobs migrated to the remaining members by the `blobstore.group.memberRemoval` Task, which must complete before the Group is updated"),
 			"fill_policy": schema.ResourceRequiredStringEnum(
 				"Fill Policy for this Blob Store - see [official documentation](https://help.sonatype.com/en/blob-stores.html#what-is-a-fill-policy-).",
 				common.BLOB_STORE_FILL_POLICY_ROUND_ROBIN,
 				common.BLOB_STORE_FILL_POLICY_WRITE_FIRST,
 			),
+			"original_renamed_to": schema.ResourceOptionalString(
+				"When set, the existing Blob Store named `name` is promoted to this Group rather than a new Group being created. " +
+					"The existing Blob Store is renamed to this value and must be listed in `members`. Only used when the Group is created - it cannot be changed afterwards.",
+			),
 			"last_updated": schema.ResourceLastUpdated(),
 		},
 	}
 }
 
+// ModifyPlan validates promotion of an existing Blob Store to this Group, so that a promotion that
+// cannot succeed is reported at plan time. As promotion only happens on create, original_renamed_to cannot
+// be changed afterwards.
+func (r *blobStoreGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		validateOriginalRenamedToUnchanged(ctx, req, resp)
+		return
+	}
+
+	// Promotion cannot be validated before the Provider has been configured
+	if !r.IsConfigured() {
+		return
+	}
+
+	var plan model.BlobStoreGroupModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		tflog.Debug(ctx, "Skipping Blob Store Group plan validation as plan contains unknown values")
+		return
+	}
+	if plan.OriginalRenamedTo.IsNull() || plan.OriginalRenamedTo.IsUnknown() {
+		return
+	}
+
+	r.validatePromotion(r.AuthContext(ctx), &plan, &resp.Diagnostics)
+}
+
+// validateOriginalRenamedToUnchanged reports a change to original_renamed_to after the Group has been created - it
+// would otherwise be planned as an update that does nothing.
+func validateOriginalRenamedToUnchanged(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	var planned, current types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("original_renamed_to"), &planned)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("original_renamed_to"), &current)...)
+	if resp.Diagnostics.HasError() || planned.IsUnknown() || planned.Equal(current) {
+		return
+	}
+
+	detail := "'original_renamed_to' is only used when the Blob Store Group is created, so cannot be set on an existing Group - remove it."
+	if !current.IsNull() {
+		detail = fmt.Sprintf("'original_renamed_to' is only used when the Blob Store Group is created, so cannot be changed afterwards - set it back to '%s'.", current.ValueString())
+	}
+	resp.Diagnostics.AddAttributeError(path.Root("original_renamed_to"), "Cannot change original_renamed_to", detail)
+}
+
+// validatePromotion reports a promotion that Sonatype Nexus Repository would refuse
+func (r *blobStoreGroupResource) validatePromotion(ctx context.Context, plan *model.BlobStoreGroupModel, respDiags *diag.Diagnostics) {
+	name := plan.Name.ValueString()
+	originalRenamedTo := plan.OriginalRenamedTo.ValueString()
+
+	if originalRenamedTo == name {
+		respDiags.AddAttributeError(
+			path.Root("original_renamed_to"),
+			"Invalid promotion to Blob Store Group",
+			fmt.Sprintf("The promoted Blob Store must be renamed - 'original_renamed_to' cannot be '%s'", name),
+		)
+		return
+	}
+	isMember := false
+	for _, m := range plan.Members {
+		if m.ValueString() == originalRenamedTo {
+			isMember = true
+		}
+	}
+	if !isMember {
+		respDiags.AddAttributeError(
+			path.Root("members"),
+			"Invalid promotion to Blob Store Group",
+			fmt.Sprintf("The promoted Blob Store '%s' must be listed in 'members'", originalRenamedTo),
+		)
+	}
+
+	blobStores, httpResponse, err := r.Services.BlobStore.ListBlobStores(ctx)
+	if err != nil {
+		errors.HandleAPIWarning(
+			"Unable to validate promotion to Blob Store Group",
+			&err,
+			httpResponse,
+			respDiags,
+		)
+		return
+	}
+	var existing *sonatyperepo.GenericBlobStoreApiResponse
+	for i, b := range blobStores {
+		switch b.GetName() {
+		case name:
+			existing = &blobStores[i]
+		case originalRenamedTo:
+			respDiags.AddAttributeError(
+				path.Root("original_renamed_to"),
+				"Invalid promotion to Blob Store Group",
+				fmt.Sprintf("A Blob Store named '%s' already exists", originalRenamedTo),
+			)
+		}
+	}
+	// The Blob Store to promote may be created earlier in the same apply
+	if existing != nil && strings.EqualFold(existing.GetType(), common.BLOB_STORE_TYPE_GROUP) {
+		respDiags.AddAttributeError(
+			path.Root("original_renamed_to"),
+			"Invalid promotion to Blob Store Group",
+			fmt.Sprintf("Blob Store '%s' is already a Group", name),
+		)
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *blobStoreGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from state
@@ -89,6 +207,10 @@ func (r *blobStoreGroupResource) Create(ctx context.Context, req resource.Create
 
 	// Call API to Create
 	ctx = r.AuthContext(ctx)
+	if !plan.OriginalRenamedTo.IsNull() {
+		r.promote(ctx, &plan, resp)
+		return
+	}
 	apiBody := sonatyperepo.NewGroupBlobStoreApiCreateRequestWithDefaults()
 	plan.MapToApiCreate(apiBody)
 	httpResponse, err := r.Services.BlobStore.CreateGroupBlobStore(ctx, *apiBody)
@@ -119,6 +241,46 @@ func (r *blobStoreGroupResource) Create(ctx context.Context, req resource.Create
 	}
 }
 
+// promote converts the existing Blob Store into this Group, then applies the planned configuration to it.
+func (r *blobStoreGroupResource) promote(ctx context.Context, plan *model.BlobStoreGroupModel, resp *resource.CreateResponse) {
+	httpResponse, err := r.Services.BlobStore.ConvertBlobStoreToGroup(ctx, plan.Name.ValueString(), plan.OriginalRenamedTo.ValueString())
+	if err != nil {
+		errors.HandleAPIError(
+			"Error promoting Blob Store to Blobstore Group",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	// The Group exists from here on, so this is never an error: an error on create would taint the Group and
+	// have the next apply destroy it along with the promoted Blob Store's content. If applying the configuration
+	// fails, the next refresh reads the Group as it actually is, and the next apply updates it in place.
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	apiBody := sonatyperepo.NewGroupBlobStoreApiUpdateRequestWithDefaults()
+	plan.MapToApiUpdate(apiBody)
+	httpResponse, err = r.Services.BlobStore.UpdateGroupBlobStore(ctx, plan.Name.ValueString(), *apiBody)
+	if err != nil {
+		errors.HandleAPIWarning(
+			"Blob Store was promoted to a Blobstore Group, but the Group could not be updated - the next apply will retry",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+	} else if httpResponse.StatusCode != http.StatusNoContent {
+		errors.HandleAPIWarning(
+			"Blob Store was promoted to a Blobstore Group, but the update of the Group was not successful - the next apply will retry",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
 // Read refreshes the Terraform state with the latest data.
 func (r *blobStoreGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Retrieve values from state
@@ -179,8 +341,25 @@ func (r *blobStoreGroupResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	// Call API to Update
 	ctx = r.AuthContext(ctx)
+
+	// Members must have their blobs migrated by a Task before they leave the Group
+	stateMembers := make([]string, 0, len(state.Members))
+	for _, m := range state.Members {
+		stateMembers = append(stateMembers, m.ValueString())
+	}
+	planMembers := make([]string, 0, len(plan.Members))
+	for _, m := range plan.Members {
+		planMembers = append(planMembers, m.ValueString())
+	}
+	for _, m := range removedGroupMembers(stateMembers, planMembers) {
+		removeGroupMember(ctx, r.Services.Task, state.Name.ValueString(), m, resp)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Call API to Update
 	apiBody := sonatyperepo.NewGroupBlobStoreApiUpdateRequestWithDefaults()
 	plan.MapToApiUpdate(apiBody)
 	httpResponse, err := r.Services.BlobStore.UpdateGroupBlobStore(ctx, state.Name.ValueString(), *apiBody)
@@ -225,7 +404,7 @@ func (r *blobStoreGroupResource) Delete(ctx context.Context, req resource.Delete
 	ctx = r.AuthContext(ctx)
 
 	// Delete API Call
-	DeleteBlobStore(r.Client, &ctx, state.Name.ValueString(), resp)
+	DeleteBlobStore(r.Client, r.Services, &ctx, state.Name.ValueString(), resp)
 }
 
 // This allows users to import existing Tasks into Terraform state.
@@ -66,11 +66,36 @@ func TestAccBlobStoreGroupResource(t *testing.T) {
 				ImportStateVerifyIdentifierAttribute: RES_ATTR_NAME,
 				ImportStateVerifyIgnore:              []string{"last_updated"},
 			},
+			// Failure: original_renamed_to cannot be set once the Group exists
+			{
+				Config:      buildTestAccBlobStoreGroupResourceNewMemberOriginalRenamedTo(randomString),
+				ExpectError: regexp.MustCompile("Cannot change original_renamed_to"),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
 
+func TestAccBlobStoreGroupResourcePromotionValidation(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Failure: Promoted Blob Store not renamed
+			{
+				Config:      buildTestAccBlobStoreGroupResourcePromotion(randomString, fmt.Sprintf("test-group-%s", randomString), fmt.Sprintf("test-group-%s", randomString)),
+				ExpectError: regexp.MustCompile("The promoted Blob Store must be renamed"),
+			},
+			// Failure: Promoted Blob Store not a member
+			{
+				Config:      buildTestAccBlobStoreGroupResourcePromotion(randomString, fmt.Sprintf("test-original-%s", randomString), "default"),
+				ExpectError: regexp.MustCompile("must be listed in 'members'"),
+			},
+		},
+	})
+}
+
 func buildTestAccBlobStoreGroupResourceNoMembers(randomString string) string {
 	return fmt.Sprintf(utils_test.ProviderConfig+`
 resource "%s" "test" {
@@ -107,3 +132,32 @@ resource "%s" "test" {
 }
 `, RES_TYPE_BLOB_STORE_FILE, randomString, randomString, RES_TYPE_BLOB_STORE_GROUP, randomString, common.BLOB_STORE_FILL_POLICY_ROUND_ROBIN, RES_TYPE_BLOB_STORE_FILE)
 }
+
+func buildTestAccBlobStoreGroupResourceNewMemberOriginalRenamedTo(randomString string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "bs" {
+	name = "test-%s"
+	path = "test-%s"
+}
+
+resource "%s" "test" {
+    name = "test-group-%s"
+    fill_policy = "%s"
+    members = [ 
+		%s.bs.name
+	]
+    original_renamed_to = %s.bs.name
+}
+`, RES_TYPE_BLOB_STORE_FILE, randomString, randomString, RES_TYPE_BLOB_STORE_GROUP, randomString, common.BLOB_STORE_FILL_POLICY_ROUND_ROBIN, RES_TYPE_BLOB_STORE_FILE, RES_TYPE_BLOB_STORE_FILE)
+}
+
+func buildTestAccBlobStoreGroupResourcePromotion(randomString, originalRenamedTo, member string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test" {
+    name = "test-group-%s"
+    fill_policy = "%s"
+    members = [ "%s" ]
+    original_renamed_to = "%s"
+}
+`, RES_TYPE_BLOB_STORE_GROUP, randomString, common.BLOB_STORE_FILL_POLICY_WRITE_FIRST, member, originalRenamedTo)
+}
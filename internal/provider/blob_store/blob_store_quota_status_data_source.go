@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package blob_store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &blobStoreQuotaStatusDataSource{}
+	_ datasource.DataSourceWithConfigure = &blobStoreQuotaStatusDataSource{}
+)
+
+// BlobStoreQuotaStatusDataSource is a helper function to simplify the provider implementation.
+func BlobStoreQuotaStatusDataSource() datasource.DataSource {
+	return &blobStoreQuotaStatusDataSource{}
+}
+
+// blobStoreQuotaStatusDataSource is the data source implementation.
+type blobStoreQuotaStatusDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *blobStoreQuotaStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blob_store_quota_status"
+}
+
+// Schema defines the schema for the data source.
+func (d *blobStoreQuotaStatusDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get the Soft Quota status and current usage of a Blob Store of any type - suited to `check` blocks",
+		Attributes: map[string]tfschema.Attribute{
+			"name":         schema.DataSourceRequiredString("Name of the Blob Store"),
+			"is_violation": schema.DataSourceComputedBool("Whether the Soft Quota of this Blob Store is currently violated - always false when no Soft Quota is configured"),
+			"message":      schema.DataSourceComputedString("Description of the Soft Quota status as reported by Sonatype Nexus Repository"),
+			"soft_quota": schema.DataSourceComputedSingleNestedAttribute(
+				"Soft Quota for this Blob Store",
+				map[string]tfschema.Attribute{
+					"type":  schema.DataSourceComputedString("Soft Quota type"),
+					"limit": schema.DataSourceComputedInt64("Quota limit"),
+				},
+			),
+			"blob_count":               schema.DataSourceComputedInt64("Number of blobs in the Blob Store"),
+			"total_size_in_bytes":      schema.DataSourceComputedInt64("Total size in bytes of the Blob Store"),
+			"available_space_in_bytes": schema.DataSourceComputedInt64("Available space in bytes for the Blob Store"),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *blobStoreQuotaStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.BlobStoreQuotaStatusModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	ctx = d.AuthContext(ctx)
+
+	quotaStatus, httpResponse, err := d.Services.BlobStore.QuotaStatus(ctx, data.Name.ValueString())
+	if err != nil {
+		errors.HandleAPIError(
+			fmt.Sprintf("Unable to read Soft Quota status of Blob Store: %s", data.Name.ValueString()),
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+	data.MapFromApi(quotaStatus)
+
+	blobStores, httpResponse, err := d.Services.BlobStore.ListBlobStores(ctx)
+	if err != nil {
+		errors.HandleAPIError(
+			"Unable to read Blob Store usage",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+	for _, blobStore := range blobStores {
+		if blobStore.GetName() == data.Name.ValueString() {
+			data.BlobStoreUsageModel.MapFromApi(&blobStore)
+			break
+		}
+	}
+
+	// Set state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
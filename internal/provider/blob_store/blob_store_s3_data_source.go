@@ -56,13 +56,8 @@ func (d *s3BlobStoreDataSource) Metadata(_ context.Context, req datasource.Metad
 func (d *s3BlobStoreDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = tfschema.Schema{
 		Description: "Use this data source to get a specific S3 Blob Store by it's name",
-		Attributes: map[string]tfschema.Attribute{
+		Attributes: blobStoreDataSourceAttributes(common.BLOB_STORE_TYPE_S3, map[string]tfschema.Attribute{
 			"name": schema.DataSourceRequiredString("Name of the Blob Store"),
-			"type": schema.DataSourceOptionalString(fmt.Sprintf("Type of this Blob Store - will always be '%s'", common.BLOB_STORE_TYPE_S3)),
-			"soft_quota": schema.DataSourceComputedOptionalSingleNestedAttribute("Soft Quota for this Blob Store", map[string]tfschema.Attribute{
-				"type":  schema.DataSourceOptionalString("Soft Quota type"),
-				"limit": schema.DataSourceOptionalInt64("Quota limit"),
-			}),
 			"bucket_configuration": schema.DataSourceComputedOptionalSingleNestedAttribute("Bucket Configuration for this Blob Store", map[string]tfschema.Attribute{
 				"bucket": schema.DataSourceComputedOptionalSingleNestedAttribute("Main Bucket Configuration for this Blob Store", map[string]tfschema.Attribute{
 					"region": schema.DataSourceOptionalString("The AWS region to create a new S3 bucket in or an existing S3 bucket's region"),
@@ -86,8 +81,14 @@ func (d *s3BlobStoreDataSource) Schema(_ context.Context, req datasource.SchemaR
 					"max_connection_pool_size": schema.DataSourceOptionalInt64("Setting this value will override the default connection pool size of Nexus of the s3 client for this blobstore"),
 				}),
 				"pre_signed_url_enabled": schema.DataSourceOptionalBool("Whether pre-signed URL is enabled or not. **Requires Sonatype Nexus Repository Manager 3.79.0 PRO or later**"),
+				"failover_buckets": schema.DataSourceComputedListNestedAttribute("Failover (replication) Buckets in other AWS regions. **Requires Sonatype Nexus Repository Manager 3.74.0 PRO or later**", tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"region":      schema.DataSourceComputedString("The AWS region of the failover S3 bucket"),
+						"bucket_name": schema.DataSourceComputedString("The name of the failover S3 bucket"),
+					},
+				}),
 			}),
-		},
+		}),
 	}
 }
 
@@ -122,7 +123,6 @@ func (d *s3BlobStoreDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	state := model.BlobStoreS3ModelDS{
 		Name: types.StringValue(data.Name.ValueString()),
-		Type: types.StringValue(common.BLOB_STORE_TYPE_S3),
 		BucketConfiguration: &model.BlobStoreS3BucketConfigurationModel{
 			Bucket: model.BlobStoreS3BucketModel{
 				Region: types.StringValue(apiResponse.BucketConfiguration.Bucket.Region),
@@ -130,12 +130,6 @@ func (d *s3BlobStoreDataSource) Read(ctx context.Context, req datasource.ReadReq
 			},
 		},
 	}
-	if apiResponse.SoftQuota != nil && apiResponse.SoftQuota.Type != nil {
-		state.SoftQuota = &model.BlobStoreSoftQuota{
-			Type:  types.StringValue(*apiResponse.SoftQuota.Type),
-			Limit: types.Int64Value(*apiResponse.SoftQuota.Limit),
-		}
-	}
 	if apiResponse.BucketConfiguration.Bucket.Prefix != nil {
 		state.BucketConfiguration.Bucket.Prefix = types.StringValue(*apiResponse.BucketConfiguration.Bucket.Prefix)
 	}
@@ -181,6 +175,16 @@ func (d *s3BlobStoreDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if apiResponse.BucketConfiguration.PreSignedUrlEnabled != nil {
 		state.BucketConfiguration.PreSignedUrlEnabled = types.BoolValue(*apiResponse.BucketConfiguration.PreSignedUrlEnabled)
 	}
+	for _, f := range apiResponse.BucketConfiguration.FailoverBuckets {
+		failoverBucket := model.BlobStoreS3FailoverBucketModel{}
+		failoverBucket.MapFromApi(&f)
+		state.BucketConfiguration.FailoverBuckets = append(state.BucketConfiguration.FailoverBuckets, failoverBucket)
+	}
+
+	readBlobStoreUsage(ctx, d.Services, data.Name.ValueString(), common.BLOB_STORE_TYPE_S3, data.IncludeRepositories, &state.BlobStoreCommonModelDS, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	// Set state
 	diags := resp.State.Set(ctx, &state)
@@ -22,9 +22,11 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -37,6 +39,12 @@ import (
 	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
 )
 
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource               = &blobStoreS3Resource{}
+	_ resource.ResourceWithModifyPlan = &blobStoreS3Resource{}
+)
+
 // blobStoreS3Resource is the resource implementation.
 type blobStoreS3Resource struct {
 	common.BaseResource
@@ -116,6 +124,20 @@ func blobStoreS3ResourceSchema(version int64) tfschema.Schema {
 			"Whether pre-signed URL is enabled or not. **Requires Sonatype Nexus Repository Manager 3.79.0 PRO or later**",
 			false,
 		)
+		failoverBucketsAttribute := schema.ResourceOptionalListNestedAttribute(
+			"Failover (replication) Buckets in other AWS regions, used when the main Bucket is unavailable - omit when there are none. **Requires Sonatype Nexus Repository Manager 3.74.0 PRO or later**",
+			tfschema.NestedAttributeObject{
+				Attributes: map[string]tfschema.Attribute{
+					"region":      schema.ResourceRequiredString("The AWS region of the failover S3 bucket - must differ from the region of the main bucket"),
+					"bucket_name": schema.ResourceRequiredString("The name of the failover S3 bucket"),
+				},
+			},
+		)
+		// Sonatype Nexus Repository does not distinguish no Failover Buckets from an empty list
+		failoverBucketsAttribute.Validators = []validator.List{
+			listvalidator.SizeAtLeast(1),
+		}
+		bucketConfigurationSchema.Attributes["failover_buckets"] = failoverBucketsAttribute
 	}
 
 	resourceSchema := tfschema.Schema{
@@ -142,6 +164,48 @@ func blobStoreS3ResourceSchema(version int64) tfschema.Schema {
 	return resourceSchema
 }
 
+// ModifyPlan reports configuration the connected Sonatype Nexus Repository does not support.
+func (r *blobStoreS3Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when destroying, or before the Provider has been configured
+	if req.Plan.Raw.IsNull() || !r.IsConfigured() {
+		return
+	}
+
+	var plan model.BlobStoreS3Model
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		tflog.Debug(ctx, "Skipping S3 Blob Store plan validation as plan contains unknown values")
+		return
+	}
+	if plan.BucketConfiguration == nil || len(plan.BucketConfiguration.FailoverBuckets) == 0 {
+		return
+	}
+
+	failoverBucketsPath := path.Root("bucket_configuration").AtName("failover_buckets")
+	if !r.NxrmVersion.SupportsS3FailoverBuckets() {
+		resp.Diagnostics.AddAttributeError(
+			failoverBucketsPath,
+			"Failover Buckets are not supported",
+			fmt.Sprintf("Failover Buckets require Sonatype Nexus Repository Manager 3.74.0 PRO or later - connected to %s", r.NxrmVersion.String()),
+		)
+		return
+	}
+
+	regions := map[string]bool{plan.BucketConfiguration.Bucket.Region.ValueString(): true}
+	for i, f := range plan.BucketConfiguration.FailoverBuckets {
+		if f.Region.IsUnknown() {
+			continue
+		}
+		if regions[f.Region.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				failoverBucketsPath.AtListIndex(i).AtName("region"),
+				"Invalid Failover Bucket",
+				fmt.Sprintf("Region '%s' is already used by the main Bucket or another Failover Bucket - each Bucket must be in a different region", f.Region.ValueString()),
+			)
+		}
+		regions[f.Region.ValueString()] = true
+	}
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *blobStoreS3Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
@@ -339,7 +403,7 @@ func (r *blobStoreS3Resource) Delete(ctx context.Context, req resource.DeleteReq
 	ctx = r.AuthContext(ctx)
 
 	// Delete API Call
-	DeleteBlobStore(r.Client, &ctx, state.Name.ValueString(), resp)
+	DeleteBlobStore(r.Client, r.Services, &ctx, state.Name.ValueString(), resp)
 }
 
 // This allows users to import existing S3 Blob Stores into Terraform state.
@@ -153,6 +153,25 @@ func TestAccBlobStoreS3ResourcePreSignedUrlValidation(t *testing.T) {
 	})
 }
 
+// TestAccBlobStoreS3ResourceFailoverBucketsValidation tests failover buckets are validated at plan time
+func TestAccBlobStoreS3ResourceFailoverBucketsValidation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Failover bucket in the same region as the main bucket (or Failover Buckets unsupported)
+			{
+				Config:      buildS3ResourceFailoverBucketsConfig("test-failover-region", "eu-west-2"),
+				ExpectError: regexp.MustCompile("Invalid Failover Bucket|Failover Buckets are not supported"),
+			},
+			// An empty list of Failover Buckets cannot be read back from Sonatype Nexus Repository
+			{
+				Config:      buildS3ResourceEmptyFailoverBucketsConfig("test-failover-empty"),
+				ExpectError: regexp.MustCompile("list must contain at least 1 elements"),
+			},
+		},
+	})
+}
+
 // TestAccBlobStoreS3ResourcePreSignedUrlWithCredentials tests pre-signed URL CRUD when AWS credentials are available
 func TestAccBlobStoreS3ResourcePreSignedUrlWithCredentials(t *testing.T) {
 	awsAccessKeyId := os.Getenv("TF_ACC_AWS_ACCESS_KEY_ID")
@@ -399,3 +418,38 @@ resource "%s" "test" {
 }
 `, RES_TYPE_BLOB_STORE_S3, randomString, randomString, randomString, awsAccessKeyId, awsAccessSecretKey)
 }
+
+func buildS3ResourceFailoverBucketsConfig(randomString, failoverRegion string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test" {
+  name = "test-s3-failover-%s"
+  bucket_configuration = {
+	bucket = {
+		region = "eu-west-2"
+		name = "nexus-bucket-failover-%s"
+	}
+	failover_buckets = [
+		{
+			region = "%s"
+			bucket_name = "nexus-bucket-failover-%s-replica"
+		}
+	]
+  }
+}
+`, RES_TYPE_BLOB_STORE_S3, randomString, randomString, failoverRegion, randomString)
+}
+
+func buildS3ResourceEmptyFailoverBucketsConfig(randomString string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test" {
+  name = "test-s3-failover-%s"
+  bucket_configuration = {
+	bucket = {
+		region = "eu-west-2"
+		name = "nexus-bucket-failover-%s"
+	}
+	failover_buckets = []
+  }
+}
+`, RES_TYPE_BLOB_STORE_S3, randomString, randomString)
+}
@@ -21,13 +21,27 @@ import (
 	"fmt"
 	"net/http"
 
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/dependency"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"terraform-provider-sonatyperepo/internal/provider/repository/format"
 
 	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
 )
 
-// Common Delete Blob Store implementation.
-func DeleteBlobStore(client *sonatyperepo.APIClient, ctx *context.Context, blobStoreName string, resp *resource.DeleteResponse) {
+// Common Delete Blob Store implementation - refuses to delete a Blob Store that is still in use.
+func DeleteBlobStore(client *sonatyperepo.APIClient, services common.Services, ctx *context.Context, blobStoreName string, resp *resource.DeleteResponse) {
+	if !dependency.GuardDelete(*ctx, services, dependency.KIND_BLOB_STORE, blobStoreName, false, &resp.Diagnostics) {
+		return
+	}
+
 	// Delete API Call
 	api_requeest := client.BlobStoreAPI.DeleteBlobStore(*ctx, blobStoreName)
 
@@ -58,3 +72,70 @@ func DeleteBlobStore(client *sonatyperepo.APIClient, ctx *context.Context, blobS
 		)
 	}
 }
+
+// Attributes common to all data sources for a single Blob Store, merged into attributes.
+func blobStoreDataSourceAttributes(blobStoreType string, attributes map[string]tfschema.Attribute) map[string]tfschema.Attribute {
+	// type and soft_quota were Optional on these data sources before they became common - keep accepting them
+	attributes["type"] = tfschema.StringAttribute{
+		Description: fmt.Sprintf("Type of this Blob Store - will always be '%s'", blobStoreType),
+		Computed:    true,
+		Optional:    true,
+	}
+	attributes["soft_quota"] = schema.DataSourceComputedOptionalSingleNestedAttribute(
+		"Soft Quota for this Blob Store",
+		map[string]tfschema.Attribute{
+			"type":  schema.DataSourceOptionalString("Soft Quota type"),
+			"limit": schema.DataSourceOptionalInt64("Quota limit"),
+		},
+	)
+	attributes["unavailable"] = schema.DataSourceComputedBool("Whether the Blob Store is unavailable for use")
+	attributes["blob_count"] = schema.DataSourceComputedInt64("Number of blobs in the Blob Store")
+	attributes["total_size_in_bytes"] = schema.DataSourceComputedInt64("Total size in bytes of the Blob Store")
+	attributes["available_space_in_bytes"] = schema.DataSourceComputedInt64("Available space in bytes for the Blob Store")
+	attributes["include_repositories"] = schema.DataSourceOptionalBool("Set to true to populate `repositories` - this reads every Repository, so is off by default")
+	attributes["repositories"] = schema.DataSourceComputedStringSet("Names of the Repositories that store content in this Blob Store - only populated when `include_repositories` is true")
+	return attributes
+}
+
+// Common read of the usage of a Blob Store for data sources.
+//
+// The Repositories using the Blob Store are only read when includeRepositories is true, as that takes a
+// request per Repository.
+func readBlobStoreUsage(ctx context.Context, services common.Services, blobStoreName string, blobStoreType string, includeRepositories types.Bool, state *model.BlobStoreCommonModelDS, diags *diag.Diagnostics) {
+	state.Type = types.StringValue(blobStoreType)
+	state.IncludeRepositories = includeRepositories
+	state.Repositories = nil
+
+	blobStores, httpResponse, err := services.BlobStore.ListBlobStores(ctx)
+	if err != nil {
+		errors.HandleAPIError(
+			"Unable to read Blob Store usage",
+			&err,
+			httpResponse,
+			diags,
+		)
+		return
+	}
+	for _, blobStore := range blobStores {
+		if blobStore.GetName() == blobStoreName {
+			state.MapFromApi(&blobStore)
+			break
+		}
+	}
+
+	if !includeRepositories.ValueBool() {
+		return
+	}
+
+	usage, httpResponse, err := format.ListRepositoryUsage(ctx, services.Repository)
+	if err != nil {
+		errors.HandleAPIError(
+			"Unable to read Repositories using Blob Store",
+			&err,
+			httpResponse,
+			diags,
+		)
+		return
+	}
+	state.MapRepositories(format.RepositoriesUsingBlobStore(usage, blobStoreName))
+}
@@ -41,6 +41,7 @@ type BlobStoreService interface {
 	CreateGroupBlobStore(ctx context.Context, body sonatyperepoV382.GroupBlobStoreApiCreateRequest) (*http.Response, error)
 	GetGroupBlobStoreConfiguration(ctx context.Context, name string) (*sonatyperepoV382.GroupBlobStoreApiModel, *http.Response, error)
 	UpdateGroupBlobStore(ctx context.Context, name string, body sonatyperepoV382.GroupBlobStoreApiUpdateRequest) (*http.Response, error)
+	ConvertBlobStoreToGroup(ctx context.Context, name string, newNameForOriginal string) (*http.Response, error)
 
 	// Azure Cloud Storage (ACS) Blob Store operations (named CreateBlobStore1/GetBlobStore1/UpdateBlobStore1 in V382)
 	CreateBlobStore1(ctx context.Context, body sonatyperepoV382.AzureBlobStoreApiModel) (*http.Response, error)
@@ -54,6 +55,9 @@ type BlobStoreService interface {
 
 	// List all blob stores
 	ListBlobStores(ctx context.Context) ([]sonatyperepoV382.GenericBlobStoreApiResponse, *http.Response, error)
+
+	// Soft Quota status of a blob store
+	QuotaStatus(ctx context.Context, name string) (*sonatyperepoV382.BlobStoreQuotaResultXO, *http.Response, error)
 }
 
 // blobStoreServiceV382 implements BlobStoreService against NXRM API client V382 (targets NXRM < 3.94.0).
@@ -106,6 +110,10 @@ func (s *blobStoreServiceV382) UpdateGroupBlobStore(ctx context.Context, name st
 	return s.client.BlobStoreAPI.UpdateGroupBlobStore(ctx, name).Body(body).Execute()
 }
 
+func (s *blobStoreServiceV382) ConvertBlobStoreToGroup(ctx context.Context, name string, newNameForOriginal string) (*http.Response, error) {
+	return s.client.BlobStoreAPI.ConvertBlobStoreToGroup(ctx, name, newNameForOriginal).Execute()
+}
+
 func (s *blobStoreServiceV382) CreateBlobStore1(ctx context.Context, body sonatyperepoV382.AzureBlobStoreApiModel) (*http.Response, error) {
 	return s.client.BlobStoreAPI.CreateBlobStore1(ctx).Body(body).Execute()
 }
@@ -134,6 +142,10 @@ func (s *blobStoreServiceV382) ListBlobStores(ctx context.Context) ([]sonatypere
 	return s.client.BlobStoreAPI.ListBlobStores(ctx).Execute()
 }
 
+func (s *blobStoreServiceV382) QuotaStatus(ctx context.Context, name string) (*sonatyperepoV382.BlobStoreQuotaResultXO, *http.Response, error) {
+	return s.client.BlobStoreAPI.QuotaStatus(ctx, name).Execute()
+}
+
 // ============================================================================
 // V395 Implementation - Bridges to V395 API via jsonBridge
 // ============================================================================
@@ -222,6 +234,10 @@ func (s *blobStoreServiceV395) UpdateGroupBlobStore(ctx context.Context, name st
 	return s.client.BlobStoreAPI.UpdateBlobstoresGroup(ctx, name).GroupBlobStoreApiUpdateRequest(v395Body).Execute()
 }
 
+func (s *blobStoreServiceV395) ConvertBlobStoreToGroup(ctx context.Context, name string, newNameForOriginal string) (*http.Response, error) {
+	return s.client.BlobStoreAPI.CreateBlobstoresGroupConvert(ctx, name, newNameForOriginal).Execute()
+}
+
 func (s *blobStoreServiceV395) CreateBlobStore1(ctx context.Context, body sonatyperepoV382.AzureBlobStoreApiModel) (*http.Response, error) {
 	var v395Body sonatyperepoV395.AzureBlobStoreApiModel
 	if err := jsonBridge(body, &v395Body); err != nil {
@@ -289,3 +305,15 @@ func (s *blobStoreServiceV395) ListBlobStores(ctx context.Context) ([]sonatypere
 	}
 	return result, httpResponse, nil
 }
+
+func (s *blobStoreServiceV395) QuotaStatus(ctx context.Context, name string) (*sonatyperepoV382.BlobStoreQuotaResultXO, *http.Response, error) {
+	v395Response, httpResponse, err := s.client.BlobStoreAPI.GetBlobstoresQuotaStatus(ctx, name).Execute()
+	if err != nil {
+		return nil, httpResponse, err
+	}
+	var result sonatyperepoV382.BlobStoreQuotaResultXO
+	if err := jsonBridge(v395Response, &result); err != nil {
+		return nil, httpResponse, err
+	}
+	return &result, httpResponse, nil
+}
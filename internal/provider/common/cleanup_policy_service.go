@@ -19,15 +19,37 @@ package common
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"reflect"
 
 	sonatyperepoV382 "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
 	sonatyperepoV395 "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v395"
 )
 
+// CleanupPolicyPreview is the Repository and criteria to preview a cleanup policy against - nil criteria are not applied.
+type CleanupPolicyPreview struct {
+	RepositoryName  string
+	LastBlobUpdated *int64
+	LastDownloaded  *int64
+	ReleaseType     *string
+	AssetRegex      *string
+	Retain          *int32
+}
+
+// toApi maps the preview to the request body shared by both client generations.
+func (p CleanupPolicyPreview) toApi() sonatyperepoV382.PreviewRequestXO {
+	return sonatyperepoV382.PreviewRequestXO{
+		RepositoryName:          &p.RepositoryName,
+		CriteriaLastBlobUpdated: p.LastBlobUpdated,
+		CriteriaLastDownloaded:  p.LastDownloaded,
+		CriteriaReleaseType:     p.ReleaseType,
+		CriteriaAssetRegex:      p.AssetRegex,
+		Retain:                  p.Retain,
+	}
+}
+
 // CleanupPolicyService abstracts cleanup policy CRUD across NXRM API client generations.
 // Every method's request/response shape is expressed in terms of the V382 generated types --
 // the vocabulary the existing internal/provider/model package's methods are already written against.
@@ -36,12 +58,16 @@ import (
 type CleanupPolicyService interface {
 	// Create creates a new cleanup policy.
 	Create(ctx context.Context, body sonatyperepoV382.CleanupPolicyResourceXO) (*http.Response, error)
-	// GetByName retrieves a cleanup policy by name.
-	GetByName(ctx context.Context, name string) (*http.Response, error)
+	// Get retrieves a cleanup policy by name.
+	Get(ctx context.Context, name string) (*sonatyperepoV382.CleanupPolicyResourceXO, *http.Response, error)
+	// List retrieves every cleanup policy.
+	List(ctx context.Context) ([]sonatyperepoV382.CleanupPolicyResourceXO, *http.Response, error)
 	// Update updates an existing cleanup policy.
 	Update(ctx context.Context, policyName string, body sonatyperepoV382.CleanupPolicyResourceXO) (*http.Response, error)
 	// DeleteByName deletes a cleanup policy by name.
 	DeleteByName(ctx context.Context, name string) (*http.Response, error)
+	// Preview returns a single page of the Components a cleanup policy with the given criteria would delete from a Repository.
+	Preview(ctx context.Context, preview CleanupPolicyPreview, continuationToken *string) (*sonatyperepoV382.PageComponentXO, *http.Response, error)
 }
 
 // cleanupPolicyServiceV382 implements CleanupPolicyService against NXRM API client V382 (targets NXRM < 3.94.0).
@@ -57,8 +83,45 @@ func (s *cleanupPolicyServiceV382) Create(ctx context.Context, body sonatyperepo
 	return s.client.CleanupPoliciesAPI.Create1(ctx).Body(body).Execute()
 }
 
-func (s *cleanupPolicyServiceV382) GetByName(ctx context.Context, name string) (*http.Response, error) {
-	return s.client.CleanupPoliciesAPI.GetCleanupPolicyByName(ctx, name).Execute()
+func (s *cleanupPolicyServiceV382) Get(ctx context.Context, name string) (*sonatyperepoV382.CleanupPolicyResourceXO, *http.Response, error) {
+	httpResponse, err := s.client.CleanupPoliciesAPI.GetCleanupPolicyByName(ctx, name).Execute()
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	var result sonatyperepoV382.CleanupPolicyResourceXO
+	if err := decodeCleanupPolicyResponse(httpResponse, &result); err != nil {
+		return nil, httpResponse, err
+	}
+	return &result, httpResponse, nil
+}
+
+func (s *cleanupPolicyServiceV382) List(ctx context.Context) ([]sonatyperepoV382.CleanupPolicyResourceXO, *http.Response, error) {
+	httpResponse, err := s.client.CleanupPoliciesAPI.GetAll(ctx).Execute()
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	var result []sonatyperepoV382.CleanupPolicyResourceXO
+	if err := decodeCleanupPolicyResponse(httpResponse, &result); err != nil {
+		return nil, httpResponse, err
+	}
+	return result, httpResponse, nil
+}
+
+// decodeCleanupPolicyResponse decodes a response body the V382 client leaves untyped.
+func decodeCleanupPolicyResponse(httpResponse *http.Response, result any) error {
+	body, err := io.ReadAll(httpResponse.Body)
+	_ = httpResponse.Body.Close()
+	if err != nil {
+		return fmt.Errorf("could not read response body: %w", err)
+	}
+	httpResponse.Body = io.NopCloser(bytes.NewReader(body))
+
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("could not parse response: %w", err)
+	}
+	return nil
 }
 
 func (s *cleanupPolicyServiceV382) Update(ctx context.Context, policyName string, body sonatyperepoV382.CleanupPolicyResourceXO) (*http.Response, error) {
@@ -69,6 +132,14 @@ func (s *cleanupPolicyServiceV382) DeleteByName(ctx context.Context, name string
 	return s.client.CleanupPoliciesAPI.DeletePolicyByName(ctx, name).Execute()
 }
 
+func (s *cleanupPolicyServiceV382) Preview(ctx context.Context, preview CleanupPolicyPreview, continuationToken *string) (*sonatyperepoV382.PageComponentXO, *http.Response, error) {
+	request := s.client.CleanupPoliciesAPI.PreviewComponents(ctx).Body(preview.toApi())
+	if continuationToken != nil {
+		request = request.ContinuationToken(*continuationToken)
+	}
+	return request.Execute()
+}
+
 // cleanupPolicyServiceV395 implements CleanupPolicyService against NXRM API client V395 (targets NXRM 3.94.0+).
 type cleanupPolicyServiceV395 struct {
 	client *sonatyperepoV395.APIClient
@@ -88,31 +159,24 @@ func (s *cleanupPolicyServiceV395) Create(ctx context.Context, body sonatyperepo
 	return s.client.CleanupPoliciesAPI.CreateCleanupPolicies(ctx).CleanupPolicyResourceXO(v395Body).Execute()
 }
 
-func (s *cleanupPolicyServiceV395) GetByName(ctx context.Context, name string) (*http.Response, error) {
-	_, httpResponse, err := s.client.CleanupPoliciesAPI.GetCleanupPolicies(ctx, name).Execute()
-	if err != nil {
-		return httpResponse, err
-	}
-
-	// V395 now declares a typed response for this endpoint (as of the client's v395.95.2 release;
-	// v395.95.0/.1 left it untyped), but callers still manually decode the body into the V382
-	// CleanupPolicyResourceXO shape for cross-generation compatibility. V395's response has an
-	// extra 'repositories' field V382's struct doesn't know about, so prune the raw body down to
-	// V382's shape here before the caller ever sees it.
-	body, readErr := io.ReadAll(httpResponse.Body)
-	_ = httpResponse.Body.Close()
-	if readErr != nil {
-		return httpResponse, fmt.Errorf("could not read response body: %w", readErr)
+func (s *cleanupPolicyServiceV395) Get(ctx context.Context, name string) (*sonatyperepoV382.CleanupPolicyResourceXO, *http.Response, error) {
+	// V395's response has an extra 'repositories' field V382's struct doesn't know about, which
+	// the bridge prunes away.
+	apiV395, httpResponse, err := s.client.CleanupPoliciesAPI.GetCleanupPolicies(ctx, name).Execute()
+	var result sonatyperepoV382.CleanupPolicyResourceXO
+	if err := bridgeFromResponse(apiV395, httpResponse, err, &result); err != nil {
+		return nil, httpResponse, err
 	}
+	return &result, httpResponse, nil
+}
 
-	pruned, pruneErr := pruneUnknownJSONFields(body, reflect.TypeOf(sonatyperepoV382.CleanupPolicyResourceXO{}))
-	if pruneErr != nil {
-		httpResponse.Body = io.NopCloser(bytes.NewReader(body))
-		return httpResponse, pruneErr
+func (s *cleanupPolicyServiceV395) List(ctx context.Context) ([]sonatyperepoV382.CleanupPolicyResourceXO, *http.Response, error) {
+	apiV395, httpResponse, err := s.client.CleanupPoliciesAPI.ListCleanupPolicies(ctx).Execute()
+	var result []sonatyperepoV382.CleanupPolicyResourceXO
+	if err := bridgeFromResponse(apiV395, httpResponse, err, &result); err != nil {
+		return nil, httpResponse, err
 	}
-
-	httpResponse.Body = io.NopCloser(bytes.NewReader(pruned))
-	return httpResponse, nil
+	return result, httpResponse, nil
 }
 
 func (s *cleanupPolicyServiceV395) Update(ctx context.Context, policyName string, body sonatyperepoV382.CleanupPolicyResourceXO) (*http.Response, error) {
@@ -128,3 +192,22 @@ func (s *cleanupPolicyServiceV395) Update(ctx context.Context, policyName string
 func (s *cleanupPolicyServiceV395) DeleteByName(ctx context.Context, name string) (*http.Response, error) {
 	return s.client.CleanupPoliciesAPI.DeleteCleanupPolicies(ctx, name).Execute()
 }
+
+func (s *cleanupPolicyServiceV395) Preview(ctx context.Context, preview CleanupPolicyPreview, continuationToken *string) (*sonatyperepoV382.PageComponentXO, *http.Response, error) {
+	// Bridge the V382-shaped request into V395 shape
+	var v395Body sonatyperepoV395.PreviewRequestXO
+	if err := jsonBridge(preview.toApi(), &v395Body); err != nil {
+		return nil, nil, err
+	}
+
+	request := s.client.CleanupPoliciesAPI.CreateCleanupPoliciesPreviewComponents(ctx).PreviewRequestXO(v395Body)
+	if continuationToken != nil {
+		request = request.ContinuationToken(*continuationToken)
+	}
+	apiV395, httpResponse, err := request.Execute()
+	var result sonatyperepoV382.PageComponentXO
+	if err := bridgeFromResponse(apiV395, httpResponse, err, &result); err != nil {
+		return nil, httpResponse, err
+	}
+	return &result, httpResponse, nil
+}
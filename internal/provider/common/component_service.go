@@ -0,0 +1,398 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+
+	sonatyperepoV382 "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+	sonatyperepoV395 "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v395"
+)
+
+// ComponentUpload describes the upload of a single asset Component via the Components API. Only
+// the fields relevant to Format are sent.
+type ComponentUpload struct {
+	Format string
+	Asset  *os.File
+
+	// raw & yum
+	Directory string
+	Filename  string
+
+	// maven2
+	GroupId     string
+	ArtifactId  string
+	Version     string
+	Packaging   string
+	Extension   string
+	Classifier  string
+	GeneratePom bool
+
+	// r
+	PathId string
+}
+
+// ComponentSearch are the criteria for searching Components and Assets - nil criteria are not applied.
+type ComponentSearch struct {
+	Repository *string
+	Format     *string
+	Group      *string
+	Name       *string
+	Version    *string
+	Prerelease *bool
+	Sha1       *string
+	Sha256     *string
+	Sha512     *string
+	Md5        *string
+	Sort       *string
+	Direction  *string
+}
+
+// ComponentService abstracts the Components and Search APIs across NXRM API client generations.
+// Every method's request/response shape is expressed in terms of the V382 generated types.
+type ComponentService interface {
+	// UploadComponent uploads a single asset Component to a hosted Repository.
+	UploadComponent(ctx context.Context, repository string, upload ComponentUpload) (*http.Response, error)
+	// GetComponent retrieves a Component by ID.
+	GetComponent(ctx context.Context, id string) (*sonatyperepoV382.ComponentXO, *http.Response, error)
+	// DeleteComponent deletes a Component and all of its assets by ID.
+	DeleteComponent(ctx context.Context, id string) (*http.Response, error)
+	// SearchComponents returns a single page of Components matching search.
+	SearchComponents(ctx context.Context, search ComponentSearch, continuationToken *string) (*sonatyperepoV382.PageComponentXO, *http.Response, error)
+	// SearchAssets returns a single page of Assets matching search.
+	SearchAssets(ctx context.Context, search ComponentSearch, continuationToken *string) (*sonatyperepoV382.PageAssetXO, *http.Response, error)
+}
+
+// componentServiceV382 implements ComponentService against NXRM API client V382 (targets NXRM < 3.94.0).
+type componentServiceV382 struct {
+	client *sonatyperepoV382.APIClient
+}
+
+func (s *componentServiceV382) UploadComponent(ctx context.Context, repository string, upload ComponentUpload) (*http.Response, error) {
+	request := s.client.ComponentsAPI.UploadComponent(ctx).Repository(repository)
+
+	switch strings.ToUpper(upload.Format) {
+	case REPO_FORMAT_APT:
+		request = request.AptAsset(upload.Asset)
+	case REPO_FORMAT_HELM:
+		request = request.HelmAsset(upload.Asset)
+	case REPO_FORMAT_MAVEN:
+		request = request.Maven2Asset1(upload.Asset).
+			Maven2Asset1Extension(upload.Extension).
+			Maven2GroupId(upload.GroupId).
+			Maven2ArtifactId(upload.ArtifactId).
+			Maven2Version(upload.Version).
+			Maven2GeneratePom(upload.GeneratePom)
+		if upload.Classifier != "" {
+			request = request.Maven2Asset1Classifier(upload.Classifier)
+		}
+		if upload.Packaging != "" {
+			request = request.Maven2Packaging(upload.Packaging)
+		}
+	case REPO_FORMAT_NPM:
+		request = request.NpmAsset(upload.Asset)
+	case REPO_FORMAT_NUGET:
+		request = request.NugetAsset(upload.Asset)
+	case REPO_FORMAT_PYPI:
+		request = request.PypiAsset(upload.Asset)
+	case REPO_FORMAT_R:
+		request = request.RAsset(upload.Asset).RAssetPathId(upload.PathId)
+	case REPO_FORMAT_RAW:
+		request = request.RawAsset1(upload.Asset).RawAsset1Filename(upload.Filename).RawDirectory(upload.Directory)
+	case REPO_FORMAT_YUM:
+		request = request.YumAsset(upload.Asset).YumAssetFilename(upload.Filename)
+		if upload.Directory != "" {
+			request = request.YumDirectory(upload.Directory)
+		}
+	default:
+		return nil, fmt.Errorf("uploading Components of format '%s' is not supported", upload.Format)
+	}
+
+	return request.Execute()
+}
+
+func (s *componentServiceV382) GetComponent(ctx context.Context, id string) (*sonatyperepoV382.ComponentXO, *http.Response, error) {
+	return s.client.ComponentsAPI.GetComponentById(ctx, id).Execute()
+}
+
+func (s *componentServiceV382) DeleteComponent(ctx context.Context, id string) (*http.Response, error) {
+	return s.client.ComponentsAPI.DeleteComponent(ctx, id).Execute()
+}
+
+func (s *componentServiceV382) SearchComponents(ctx context.Context, search ComponentSearch, continuationToken *string) (*sonatyperepoV382.PageComponentXO, *http.Response, error) {
+	request := s.client.SearchAPI.Search(ctx)
+	if continuationToken != nil {
+		request = request.ContinuationToken(*continuationToken)
+	}
+	if search.Repository != nil {
+		request = request.Repository(*search.Repository)
+	}
+	if search.Format != nil {
+		request = request.Format(*search.Format)
+	}
+	if search.Group != nil {
+		request = request.Group(*search.Group)
+	}
+	if search.Name != nil {
+		request = request.Name(*search.Name)
+	}
+	if search.Version != nil {
+		request = request.Version(*search.Version)
+	}
+	if search.Prerelease != nil {
+		request = request.Prerelease(strconv.FormatBool(*search.Prerelease))
+	}
+	if search.Sha1 != nil {
+		request = request.Sha1(*search.Sha1)
+	}
+	if search.Sha256 != nil {
+		request = request.Sha256(*search.Sha256)
+	}
+	if search.Sha512 != nil {
+		request = request.Sha512(*search.Sha512)
+	}
+	if search.Md5 != nil {
+		request = request.Md5(*search.Md5)
+	}
+	if search.Sort != nil {
+		request = request.Sort(*search.Sort)
+	}
+	if search.Direction != nil {
+		request = request.Direction(*search.Direction)
+	}
+	return request.Execute()
+}
+
+func (s *componentServiceV382) SearchAssets(ctx context.Context, search ComponentSearch, continuationToken *string) (*sonatyperepoV382.PageAssetXO, *http.Response, error) {
+	request := s.client.SearchAPI.SearchAssets(ctx)
+	if continuationToken != nil {
+		request = request.ContinuationToken(*continuationToken)
+	}
+	if search.Repository != nil {
+		request = request.Repository(*search.Repository)
+	}
+	if search.Format != nil {
+		request = request.Format(*search.Format)
+	}
+	if search.Group != nil {
+		request = request.Group(*search.Group)
+	}
+	if search.Name != nil {
+		request = request.Name(*search.Name)
+	}
+	if search.Version != nil {
+		request = request.Version(*search.Version)
+	}
+	if search.Prerelease != nil {
+		request = request.Prerelease(strconv.FormatBool(*search.Prerelease))
+	}
+	if search.Sha1 != nil {
+		request = request.Sha1(*search.Sha1)
+	}
+	if search.Sha256 != nil {
+		request = request.Sha256(*search.Sha256)
+	}
+	if search.Sha512 != nil {
+		request = request.Sha512(*search.Sha512)
+	}
+	if search.Md5 != nil {
+		request = request.Md5(*search.Md5)
+	}
+	if search.Sort != nil {
+		request = request.Sort(*search.Sort)
+	}
+	if search.Direction != nil {
+		request = request.Direction(*search.Direction)
+	}
+	return request.Execute()
+}
+
+// componentServiceV395 implements ComponentService against NXRM API client V395 (targets NXRM 3.94.0+).
+type componentServiceV395 struct {
+	client *sonatyperepoV395.APIClient
+}
+
+func (s *componentServiceV395) UploadComponent(ctx context.Context, repository string, upload ComponentUpload) (*http.Response, error) {
+	request := s.client.ComponentsAPI.CreateComponents(ctx).Repository(repository)
+
+	switch strings.ToUpper(upload.Format) {
+	case REPO_FORMAT_APT:
+		request = request.AptAsset(upload.Asset)
+	case REPO_FORMAT_HELM:
+		request = request.HelmAsset(upload.Asset)
+	case REPO_FORMAT_MAVEN:
+		request = request.Maven2Asset1(upload.Asset).
+			Maven2Asset1Extension(upload.Extension).
+			Maven2GroupId(upload.GroupId).
+			Maven2ArtifactId(upload.ArtifactId).
+			Maven2Version(upload.Version).
+			Maven2GeneratePom(upload.GeneratePom)
+		if upload.Classifier != "" {
+			request = request.Maven2Asset1Classifier(upload.Classifier)
+		}
+		if upload.Packaging != "" {
+			request = request.Maven2Packaging(upload.Packaging)
+		}
+	case REPO_FORMAT_NPM:
+		request = request.NpmAsset(upload.Asset)
+	case REPO_FORMAT_NUGET:
+		request = request.NugetAsset(upload.Asset)
+	case REPO_FORMAT_PYPI:
+		request = request.PypiAsset(upload.Asset)
+	case REPO_FORMAT_R:
+		request = request.RAsset(upload.Asset).RAssetPathId(upload.PathId)
+	case REPO_FORMAT_RAW:
+		request = request.RawAsset1(upload.Asset).RawAsset1Filename(upload.Filename).RawDirectory(upload.Directory)
+	case REPO_FORMAT_YUM:
+		request = request.YumAsset(upload.Asset).YumAssetFilename(upload.Filename)
+		if upload.Directory != "" {
+			request = request.YumDirectory(upload.Directory)
+		}
+	default:
+		return nil, fmt.Errorf("uploading Components of format '%s' is not supported", upload.Format)
+	}
+
+	return request.Execute()
+}
+
+func (s *componentServiceV395) GetComponent(ctx context.Context, id string) (*sonatyperepoV382.ComponentXO, *http.Response, error) {
+	apiV395, httpResponse, err := s.client.ComponentsAPI.GetComponents(ctx, id).Execute()
+	var result sonatyperepoV382.ComponentXO
+	if err := bridgeFromResponse(apiV395, httpResponse, err, &result); err != nil {
+		return nil, httpResponse, err
+	}
+	return &result, httpResponse, nil
+}
+
+func (s *componentServiceV395) DeleteComponent(ctx context.Context, id string) (*http.Response, error) {
+	return s.client.ComponentsAPI.DeleteComponents(ctx, id).Execute()
+}
+
+func (s *componentServiceV395) SearchComponents(ctx context.Context, search ComponentSearch, continuationToken *string) (*sonatyperepoV382.PageComponentXO, *http.Response, error) {
+	request := s.client.SearchAPI.ListSearch(ctx)
+	if continuationToken != nil {
+		request = request.ContinuationToken(*continuationToken)
+	}
+	if search.Repository != nil {
+		request = request.Repository(*search.Repository)
+	}
+	if search.Format != nil {
+		request = request.Format(*search.Format)
+	}
+	if search.Group != nil {
+		request = request.Group(*search.Group)
+	}
+	if search.Name != nil {
+		request = request.Name(*search.Name)
+	}
+	if search.Version != nil {
+		request = request.Version(*search.Version)
+	}
+	if search.Prerelease != nil {
+		request = request.Prerelease(strconv.FormatBool(*search.Prerelease))
+	}
+	if search.Sha1 != nil {
+		request = request.Sha1(*search.Sha1)
+	}
+	if search.Sha256 != nil {
+		request = request.Sha256(*search.Sha256)
+	}
+	if search.Sha512 != nil {
+		request = request.Sha512(*search.Sha512)
+	}
+	if search.Md5 != nil {
+		request = request.Md5(*search.Md5)
+	}
+	if search.Sort != nil {
+		request = request.Sort(*search.Sort)
+	}
+	if search.Direction != nil {
+		request = request.Direction(*search.Direction)
+	}
+
+	apiV395, httpResponse, err := request.Execute()
+	var result sonatyperepoV382.PageComponentXO
+	if err := bridgeFromResponse(apiV395, httpResponse, err, &result); err != nil {
+		return nil, httpResponse, err
+	}
+	return &result, httpResponse, nil
+}
+
+func (s *componentServiceV395) SearchAssets(ctx context.Context, search ComponentSearch, continuationToken *string) (*sonatyperepoV382.PageAssetXO, *http.Response, error) {
+	request := s.client.SearchAPI.ListSearchAssets(ctx)
+	if continuationToken != nil {
+		request = request.ContinuationToken(*continuationToken)
+	}
+	if search.Repository != nil {
+		request = request.Repository(*search.Repository)
+	}
+	if search.Format != nil {
+		request = request.Format(*search.Format)
+	}
+	if search.Group != nil {
+		request = request.Group(*search.Group)
+	}
+	if search.Name != nil {
+		request = request.Name(*search.Name)
+	}
+	if search.Version != nil {
+		request = request.Version(*search.Version)
+	}
+	if search.Prerelease != nil {
+		request = request.Prerelease(strconv.FormatBool(*search.Prerelease))
+	}
+	if search.Sha1 != nil {
+		request = request.Sha1(*search.Sha1)
+	}
+	if search.Sha256 != nil {
+		request = request.Sha256(*search.Sha256)
+	}
+	if search.Sha512 != nil {
+		request = request.Sha512(*search.Sha512)
+	}
+	if search.Md5 != nil {
+		request = request.Md5(*search.Md5)
+	}
+	if search.Sort != nil {
+		request = request.Sort(*search.Sort)
+	}
+	if search.Direction != nil {
+		request = request.Direction(*search.Direction)
+	}
+
+	apiV395, httpResponse, err := request.Execute()
+	var result sonatyperepoV382.PageAssetXO
+	if err := bridgeFromResponse(apiV395, httpResponse, err, &result); err != nil {
+		return nil, httpResponse, err
+	}
+	return &result, httpResponse, nil
+}
+
+// NewComponentServiceV382 creates a V382 ComponentService adapter.
+func NewComponentServiceV382(client *sonatyperepoV382.APIClient) ComponentService {
+	return &componentServiceV382{client: client}
+}
+
+// NewComponentServiceV395 creates a V395 ComponentService adapter.
+func NewComponentServiceV395(client *sonatyperepoV395.APIClient) ComponentService {
+	return &componentServiceV395{client: client}
+}
@@ -73,9 +73,15 @@ const (
 	PROTOCOL_LDAPS                                                         string = "LDAPS"
 	TASK_REPOSITORY_DOCKER_GC_DEFAULT_DEPLOY_OFFSET                        int32  = 24
 	TASK_REPOSITORY_DOCKER_UPLOAD_PURGE_DEFAULT_AGE                        int32  = 24
+	TASK_REPOSITORY_IMPORT_DEFAULT_DELETE_SOURCE_FILES                     bool   = false
+	TASK_REPOSITORY_IMPORT_DEFAULT_DRY_RUN                                 bool   = false
+	TASK_REPOSITORY_IMPORT_DEFAULT_HARD_LINKS_ENABLED                      bool   = false
+	TASK_REPOSITORY_MAVEN_REBUILD_METADATA_DEFAULT_REBUILD_CHECKSUMS       bool   = false
 	TASK_REPOSITORY_MAVEN_REMOVE_SNAPSHOTS_DEFAULT_MINIMUM_RETAINED        int32  = 1
 	TASK_REPOSITORY_MAVEN_REMOVE_SNAPSHOTS_DEFAULT_REMOVE_IF_RELEASED      bool   = false
 	TASK_REPOSITORY_MAVEN_REMOVE_SNAPSHOTS_DEFAULT_SNAPSHOT_RETENTION_DAYS int32  = 30
+	TASK_TAGS_CLEANUP_ALL_REPOSITORIES                                     string = "*"
+	TASK_TAGS_CLEANUP_DEFAULT_DELETE_ASSOCIATED_COMPONENTS                 bool   = false
 	SECURITY_USER_TOKEN_DEFAULT_ENABLED                                    bool   = false
 	SECURITY_USER_TOKEN_DEFAULT_EXPIRATION_DAYS                            int32  = 1
 	SECURITY_USER_TOKEN_DEFAULT_EXPIRATION_ENABLED                         bool   = false
@@ -17,7 +17,11 @@
 package common
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 
 	sonatyperepoV382 "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
@@ -34,6 +38,85 @@ type ContentSelectorService interface {
 	UpdateContentSelector(ctx context.Context, name string, body sonatyperepoV382.ContentSelectorApiUpdateRequest) (*http.Response, error)
 	DeleteContentSelector(ctx context.Context, name string) (*http.Response, error)
 	GetContentSelectors(ctx context.Context) ([]sonatyperepoV382.ContentSelectorApiResponse, *http.Response, error)
+	PreviewContentSelector(ctx context.Context, preview ContentSelectorPreview) (*ContentSelectorPreviewResult, *http.Response, error)
+}
+
+const (
+	// CONTENT_SELECTOR_PREVIEW_ALL_REPOSITORIES previews a Content Selector against every Repository - prefix a
+	// format (e.g. "*maven2") to preview against every Repository of that format
+	CONTENT_SELECTOR_PREVIEW_ALL_REPOSITORIES = "*"
+
+	// contentSelectorPreviewPath is the endpoint the Sonatype Nexus Repository UI previews Content Selectors with -
+	// neither generated client exposes it, as it is not part of the public REST API. Supported for 3.82.0 to 3.95.1.
+	contentSelectorPreviewPath = "/internal/ui/content-selectors/preview"
+)
+
+// ContentSelectorPreview asks which Assets a Content Selector expression matches.
+type ContentSelectorPreview struct {
+	Type       string `json:"type"`
+	Expression string `json:"expression"`
+	Repository string `json:"repository"`
+}
+
+// ContentSelectorPreviewAsset is an Asset matched by a Content Selector expression.
+type ContentSelectorPreviewAsset struct {
+	Id         string `json:"id"`
+	Repository string `json:"repository"`
+	Format     string `json:"format"`
+	Name       string `json:"name"`
+}
+
+// ContentSelectorPreviewResult are the Assets matched by a Content Selector expression - Total may exceed the
+// number of Results returned.
+type ContentSelectorPreviewResult struct {
+	Total   int64                         `json:"total"`
+	Results []ContentSelectorPreviewAsset `json:"results"`
+}
+
+// previewContentSelector calls the preview endpoint directly, through the HTTP client and server of the
+// calling client generation, authenticating as set up by WithAuth.
+func previewContentSelector(ctx context.Context, httpClient *http.Client, serverUrl, userAgent string, preview ContentSelectorPreview) (*ContentSelectorPreviewResult, *http.Response, error) {
+	preview.Type = "csel"
+	body, err := json.Marshal(preview)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, serverUrl+contentSelectorPreviewPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", userAgent)
+	if auth, ok := ctx.Value(sonatyperepoV382.ContextBasicAuth).(sonatyperepoV382.BasicAuth); ok {
+		req.SetBasicAuth(auth.UserName, auth.Password)
+	}
+
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	httpResponse, err := httpClient.Do(req)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	responseBody, err := io.ReadAll(httpResponse.Body)
+	_ = httpResponse.Body.Close()
+	if err != nil {
+		return nil, httpResponse, err
+	}
+	httpResponse.Body = io.NopCloser(bytes.NewReader(responseBody))
+
+	if httpResponse.StatusCode >= 300 {
+		return nil, httpResponse, fmt.Errorf("%s", httpResponse.Status)
+	}
+
+	var result ContentSelectorPreviewResult
+	if err := json.Unmarshal(responseBody, &result); err != nil {
+		return nil, httpResponse, err
+	}
+	return &result, httpResponse, nil
 }
 
 // contentSelectorServiceV382 implements ContentSelectorService against NXRM API client V382 (targets NXRM < 3.94.0).
@@ -61,6 +144,11 @@ func (s *contentSelectorServiceV382) GetContentSelectors(ctx context.Context) ([
 	return s.client.ContentSelectorsAPI.GetContentSelectors(ctx).Execute()
 }
 
+func (s *contentSelectorServiceV382) PreviewContentSelector(ctx context.Context, preview ContentSelectorPreview) (*ContentSelectorPreviewResult, *http.Response, error) {
+	config := s.client.GetConfig()
+	return previewContentSelector(ctx, config.HTTPClient, config.Servers[0].URL, config.UserAgent, preview)
+}
+
 // contentSelectorServiceV395 implements ContentSelectorService against NXRM API client V395 (targets NXRM 3.94.0+).
 // Requests/responses are bridged to/from V382 shapes via JSON, since the internal/provider/model
 // package's mapping methods are written in terms of V382 types.
@@ -131,3 +219,8 @@ func (s *contentSelectorServiceV395) GetContentSelectors(ctx context.Context) ([
 	}
 	return result, httpResponse, nil
 }
+
+func (s *contentSelectorServiceV395) PreviewContentSelector(ctx context.Context, preview ContentSelectorPreview) (*ContentSelectorPreviewResult, *http.Response, error) {
+	config := s.client.GetConfig()
+	return previewContentSelector(ctx, config.HTTPClient, config.Servers[0].URL, config.UserAgent, preview)
+}
@@ -0,0 +1,72 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	sonatyperepoV382 "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+)
+
+func TestPreviewContentSelector(t *testing.T) {
+	var received ContentSelectorPreview
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/service/rest"+contentSelectorPreviewPath, r.URL.Path)
+		username, password, ok := r.BasicAuth()
+		assert.True(t, ok)
+		assert.Equal(t, "admin", username)
+		assert.Equal(t, "secret", password)
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"total": 2, "results": [{"id": "a1", "repository": "raw-hosted", "format": "raw", "name": "/team-a/file.txt"}]}`))
+	}))
+	defer server.Close()
+
+	ctx := WithAuth(context.Background(), sonatyperepoV382.BasicAuth{UserName: "admin", Password: "secret"})
+	result, httpResponse, err := previewContentSelector(ctx, server.Client(), server.URL+"/service/rest", "test", ContentSelectorPreview{
+		Expression: `path =^ "/team-a/"`,
+		Repository: "*raw",
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusOK, httpResponse.StatusCode)
+	assert.Equal(t, ContentSelectorPreview{Type: "csel", Expression: `path =^ "/team-a/"`, Repository: "*raw"}, received)
+	assert.Equal(t, int64(2), result.Total)
+	assert.Equal(t, []ContentSelectorPreviewAsset{{Id: "a1", Repository: "raw-hosted", Format: "raw", Name: "/team-a/file.txt"}}, result.Results)
+}
+
+func TestPreviewContentSelectorError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`Invalid expression`))
+	}))
+	defer server.Close()
+
+	result, httpResponse, err := previewContentSelector(context.Background(), server.Client(), server.URL, "test", ContentSelectorPreview{})
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, http.StatusBadRequest, httpResponse.StatusCode)
+}
@@ -173,6 +173,10 @@ func (s *SystemVersion) RequiresLowerCaseRepostioryNameDocker() bool {
 	return s.NewerThan(3, 89, 0, 0)
 }
 
+func (s *SystemVersion) SupportsCleanupPolicyRetain() bool {
+	return s.ProVersion && s.NewerThan(3, 58, 0, 0)
+}
+
 func (s *SystemVersion) SupportsCapabilities() bool {
 	return s.NewerThan(3, 84, 0, 0)
 }
@@ -181,6 +185,10 @@ func (s *SystemVersion) SupportsInlineFirewall() bool {
 	return s.NewerThan(3, 94, 0, 0)
 }
 
+func (s *SystemVersion) SupportsS3FailoverBuckets() bool {
+	return s.ProVersion && s.NewerThan(3, 74, 0, 0)
+}
+
 // FirewallMode represents the inline `firewall.mode` value supported by NXRM 3.94+
 // proxy repository APIs, replacing the separate Capability-based firewall configuration.
 type FirewallMode string
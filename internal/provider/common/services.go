@@ -45,6 +45,7 @@ type Services struct {
 	License         LicenseService
 	HttpSettings    HttpSettingsService
 	OAuth2          OAuth2Service
+	Component       ComponentService
 }
 
 // NewServices resolves every domain service to the client generation appropriate for
@@ -77,6 +78,7 @@ func NewServices(version SystemVersion, clientV382 *sonatyperepoV382.APIClient,
 			License:         NewLicenseServiceV395(clientV395),
 			HttpSettings:    NewHttpSettingsServiceV395(clientV395),
 			OAuth2:          NewOAuth2ServiceV395(clientV395),
+			Component:       NewComponentServiceV395(clientV395),
 		}
 	}
 	return Services{
@@ -101,5 +103,6 @@ func NewServices(version SystemVersion, clientV382 *sonatyperepoV382.APIClient,
 		License:         NewLicenseServiceV382(clientV382),
 		HttpSettings:    NewHttpSettingsServiceV382(clientV382),
 		OAuth2:          NewOAuth2ServiceUnsupported(),
+		Component:       NewComponentServiceV382(clientV382),
 	}
 }
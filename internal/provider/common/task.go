@@ -18,6 +18,17 @@ package common
 
 type TaskType string
 
+// Task states and run results reported by the Tasks API.
+const (
+	TASK_CURRENT_STATE_DONE    string = "DONE"
+	TASK_CURRENT_STATE_RUNNING string = "RUNNING"
+	TASK_CURRENT_STATE_WAITING string = "WAITING"
+	TASK_LAST_RUN_RESULT_OK    string = "OK"
+)
+
+// Number of upcoming runs reported in the next_fire_times of a Task.
+const TASK_NEXT_FIRE_TIMES_COUNT = 5
+
 const (
 	TASK_TYPE_BLOBSTORE_COMPACT                                 TaskType = "blobstore.compact"
 	TASK_TYPE_BLOBSTORE_DELETE_TEMP_FILES                       TaskType = "blobstore.delete-temp-files"
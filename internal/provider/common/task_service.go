@@ -19,6 +19,7 @@ package common
 import (
 	"context"
 	"net/http"
+	"time"
 
 	sonatyperepoV382 "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
 	sonatyperepoV395 "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v395"
@@ -51,6 +52,11 @@ type TaskApiModel struct {
 	RecurringDays         []int32
 	CronExpression        *string
 	Properties            *map[string]string
+	CurrentState          *string
+	LastRun               *time.Time
+	LastRunResult         *string
+	NextRun               *time.Time
+	Message               *string
 }
 
 // TaskCreateApiModel is a generation-agnostic representation of the request body used to
@@ -84,6 +90,7 @@ type TaskService interface {
 	CreateTask(ctx context.Context, plan *TaskCreateApiModel) (*TaskApiModel, *http.Response, error)
 	UpdateTask(ctx context.Context, id string, plan *TaskUpdateApiModel) (*http.Response, error)
 	DeleteTaskById(ctx context.Context, id string) (*http.Response, error)
+	RunTask(ctx context.Context, id string) (*http.Response, error)
 }
 
 func taskFrequencyToApiModelV382(f TaskFrequencyApiModel) sonatyperepoV382.FrequencyXO {
@@ -122,9 +129,14 @@ func taskApiModelFromV382(api *sonatyperepoV382.TaskXO) *TaskApiModel {
 		RecurringDays:         api.RecurringDays,
 		CronExpression:        api.CronExpression,
 		Properties:            api.Properties,
+		CurrentState:          api.CurrentState,
+		LastRun:               api.LastRun,
+		LastRunResult:         api.LastRunResult,
+		NextRun:               api.NextRun,
+		Message:               api.Message,
 	}
 	if api.StartDate != nil {
-		unix := api.StartDate.Unix()
+		unix := api.StartDate.UnixMilli()
 		m.StartDate = &unix
 	}
 	return m
@@ -146,9 +158,14 @@ func taskApiModelFromV395(api *sonatyperepoV395.TaskXO) *TaskApiModel {
 		RecurringDays:         api.RecurringDays,
 		CronExpression:        api.CronExpression,
 		Properties:            api.Properties,
+		CurrentState:          api.CurrentState,
+		LastRun:               api.LastRun,
+		LastRunResult:         api.LastRunResult,
+		NextRun:               api.NextRun,
+		Message:               api.Message,
 	}
 	if api.StartDate != nil {
-		unix := api.StartDate.Unix()
+		unix := api.StartDate.UnixMilli()
 		m.StartDate = &unix
 	}
 	return m
@@ -213,6 +230,10 @@ func (s *taskServiceV382) DeleteTaskById(ctx context.Context, id string) (*http.
 	return s.client.TasksAPI.DeleteTaskById(ctx, id).Execute()
 }
 
+func (s *taskServiceV382) RunTask(ctx context.Context, id string) (*http.Response, error) {
+	return s.client.TasksAPI.Run(ctx, id).Execute()
+}
+
 // taskServiceV395 implements TaskService against NXRM API client V395 (targets NXRM 3.94.0+).
 type taskServiceV395 struct {
 	client *sonatyperepoV395.APIClient
@@ -271,3 +292,7 @@ func (s *taskServiceV395) UpdateTask(ctx context.Context, id string, plan *TaskU
 func (s *taskServiceV395) DeleteTaskById(ctx context.Context, id string) (*http.Response, error) {
 	return s.client.TasksAPI.DeleteTasks(ctx, id).Execute()
 }
+
+func (s *taskServiceV395) RunTask(ctx context.Context, id string) (*http.Response, error) {
+	return s.client.TasksAPI.CreateTasksRun(ctx, id).Execute()
+}
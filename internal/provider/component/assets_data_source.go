@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &assetsDataSource{}
+	_ datasource.DataSourceWithConfigure = &assetsDataSource{}
+)
+
+// AssetsDataSource is a helper function to simplify the provider implementation.
+func AssetsDataSource() datasource.DataSource {
+	return &assetsDataSource{}
+}
+
+// assetsDataSource is the data source implementation.
+type assetsDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *assetsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_assets"
+}
+
+// Schema defines the schema for the data source.
+func (d *assetsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := searchFilterAttributes()
+	attributes["assets"] = schema.DataSourceComputedListNestedAttribute(
+		"Assets matching the search criteria",
+		tfschema.NestedAttributeObject{
+			Attributes: assetAttributes(),
+		},
+	)
+
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to search for Assets using the Search API",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *assetsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state model.AssetsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = d.AuthContext(ctx)
+
+	results, httpResponse, err := searchAllAssets(ctx, d.Services.Component, state.MapToSearch())
+	if err != nil {
+		errors.HandleAPIError(
+			"Unable to search Assets",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d Assets", len(results)))
+
+	state.Assets = make([]model.AssetModel, 0, len(results))
+	for _, a := range results {
+		asset := model.AssetModel{}
+		asset.MapFromApi(&a)
+		state.Assets = append(state.Assets, asset)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
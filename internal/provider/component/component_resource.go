@@ -0,0 +1,498 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+
+	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+const (
+	// componentSearchMaxAttempts is how many times the Search API is queried for a newly uploaded
+	// Component, as it is only searchable once Sonatype Nexus Repository has indexed it
+	componentSearchMaxAttempts   = 10
+	componentSearchRetryInterval = 1 * time.Second
+
+	errComponentFormatBlockRequired = "Attribute '%s' is required when format is '%s'"
+	errComponentFormatBlockInvalid  = "Attribute '%s' is only valid when format is '%s'"
+	errComponentFileChanged         = "file '%s' was modified after the plan was created"
+	errComponentNotFound            = "uploaded Component with SHA-256 '%s' was not found in Repository '%s' after %d attempts"
+	errComponentAmbiguous           = "%d Components with SHA-256 '%s' were found in Repository '%s' at the uploaded coordinates - unable to tell which was uploaded"
+)
+
+// componentFormatBlocks are the format specific attributes, keyed by the format they apply to
+var componentFormatBlocks = map[string]string{
+	strings.ToLower(common.REPO_FORMAT_MAVEN): "maven2",
+	strings.ToLower(common.REPO_FORMAT_R):     "r",
+	strings.ToLower(common.REPO_FORMAT_RAW):   "raw",
+	strings.ToLower(common.REPO_FORMAT_YUM):   "yum",
+}
+
+// componentResource is the resource implementation.
+type componentResource struct {
+	common.BaseResource
+}
+
+// NewComponentResource is a helper function to simplify the provider implementation.
+func NewComponentResource() resource.Resource {
+	return &componentResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *componentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_component"
+}
+
+// Schema defines the schema for the resource.
+func (r *componentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	requiresReplace := []planmodifier.Object{objectplanmodifier.RequiresReplace()}
+
+	rawAttribute := schema.ResourceOptionalSingleNestedAttribute(
+		"Raw specific upload attributes - required when format is 'raw'",
+		map[string]tfschema.Attribute{
+			"directory": schema.ResourceRequiredString("Directory the asset is uploaded to"),
+			"filename":  schema.ResourceRequiredString("Filename of the asset"),
+		},
+	)
+	rawAttribute.PlanModifiers = requiresReplace
+
+	maven2Attribute := schema.ResourceOptionalSingleNestedAttribute(
+		"Maven specific upload attributes - required when format is 'maven2'",
+		map[string]tfschema.Attribute{
+			"group_id":     schema.ResourceRequiredString("Maven Group ID"),
+			"artifact_id":  schema.ResourceRequiredString("Maven Artifact ID"),
+			"version":      schema.ResourceRequiredString("Maven Version"),
+			"packaging":    schema.ResourceOptionalString("Maven Packaging"),
+			"extension":    schema.ResourceRequiredString("Extension of the asset"),
+			"classifier":   schema.ResourceOptionalString("Classifier of the asset"),
+			"generate_pom": schema.ResourceOptionalBoolWithDefault("Whether to generate a POM file for the Component", false),
+		},
+	)
+	maven2Attribute.PlanModifiers = requiresReplace
+
+	yumAttribute := schema.ResourceOptionalSingleNestedAttribute(
+		"Yum specific upload attributes - required when format is 'yum'",
+		map[string]tfschema.Attribute{
+			"directory": schema.ResourceOptionalString("Directory the asset is uploaded to"),
+			"filename":  schema.ResourceRequiredString("Filename of the asset"),
+		},
+	)
+	yumAttribute.PlanModifiers = requiresReplace
+
+	rAttribute := schema.ResourceOptionalSingleNestedAttribute(
+		"R specific upload attributes - required when format is 'r'",
+		map[string]tfschema.Attribute{
+			"path_id": schema.ResourceRequiredString("Package path of the asset"),
+		},
+	)
+	rAttribute.PlanModifiers = requiresReplace
+
+	resp.Schema = tfschema.Schema{
+		Description: `Upload a single asset Component to a hosted Repository in Sonatype Nexus Repository.
+
+The Component is replaced whenever the SHA-256 checksum of the local file changes, or no longer matches the asset stored in Sonatype Nexus Repository.`,
+		Attributes: map[string]tfschema.Attribute{
+			"repository": schema.ResourceRequiredStringWithPlanModifier(
+				"Name of the hosted Repository to upload the Component to",
+				[]planmodifier.String{stringplanmodifier.RequiresReplace()},
+			),
+			"format": schema.ResourceRequiredStringEnumWithPlanModifier(
+				"Format of the Component - must match the format of the Repository",
+				[]planmodifier.String{stringplanmodifier.RequiresReplace()},
+				componentFormats()...,
+			),
+			"file":         schema.ResourceRequiredString("Path to the local file to upload"),
+			"file_sha256":  schema.ResourceComputedString("SHA-256 checksum of the uploaded file"),
+			"raw":          rawAttribute,
+			"maven2":       maven2Attribute,
+			"yum":          yumAttribute,
+			"r":            rAttribute,
+			"component_id": schema.ResourceComputedStringWithPlanModifier("ID of the Component", stringplanmodifier.UseStateForUnknown()),
+			"group":        schema.ResourceComputedStringWithPlanModifier("Group of the Component", stringplanmodifier.UseStateForUnknown()),
+			"name":         schema.ResourceComputedStringWithPlanModifier("Name of the Component", stringplanmodifier.UseStateForUnknown()),
+			"version":      schema.ResourceComputedStringWithPlanModifier("Version of the Component", stringplanmodifier.UseStateForUnknown()),
+			"asset_path":   schema.ResourceComputedStringWithPlanModifier("Path of the uploaded asset", stringplanmodifier.UseStateForUnknown()),
+			"download_url": schema.ResourceComputedStringWithPlanModifier("Download URL of the uploaded asset", stringplanmodifier.UseStateForUnknown()),
+			"last_updated": schema.ResourceLastUpdated(),
+		},
+	}
+}
+
+// ModifyPlan plans the checksum of the local file, forcing replacement when it differs from the
+// checksum of the asset in Sonatype Nexus Repository.
+func (r *componentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to plan when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan model.ComponentModelResource
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		tflog.Debug(ctx, "Skipping Component plan modification as plan contains unknown values")
+		return
+	}
+
+	validateFormatBlocks(&plan, &resp.Diagnostics)
+
+	// The file may be produced by another resource during apply
+	if plan.File.IsUnknown() {
+		return
+	}
+
+	// The file may also be created during apply at a path known now, by another resource or a build step -
+	// its checksum is then only known once it has been uploaded
+	checksum := types.StringUnknown()
+	fileChecksum, err := fileSha256(plan.File.ValueString())
+	if err == nil {
+		checksum = types.StringValue(fileChecksum)
+	} else if !os.IsNotExist(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("file"),
+			"Unable to read file to upload",
+			err.Error(),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_sha256"), checksum)...)
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+	var stateChecksum types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("file_sha256"), &stateChecksum)...)
+	if !stateChecksum.Equal(checksum) {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("file_sha256"))
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *componentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan model.ComponentModelResource
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	checksum, err := fileSha256(plan.File.ValueString())
+	if err == nil && !plan.FileSha256.IsUnknown() && plan.FileSha256.ValueString() != checksum {
+		err = fmt.Errorf(errComponentFileChanged, plan.File.ValueString())
+	}
+	if err != nil {
+		errors.HandleAPIError(
+			"Error reading file to upload",
+			&err,
+			nil,
+			&resp.Diagnostics,
+		)
+		return
+	}
+	plan.FileSha256 = types.StringValue(checksum)
+
+	asset, err := os.Open(plan.File.ValueString())
+	if err != nil {
+		errors.HandleAPIError(
+			"Error opening file to upload",
+			&err,
+			nil,
+			&resp.Diagnostics,
+		)
+		return
+	}
+	defer func() { _ = asset.Close() }()
+
+	// Call API to Create
+	ctx = r.AuthContext(ctx)
+	httpResponse, err := r.Services.Component.UploadComponent(ctx, plan.Repository.ValueString(), plan.MapToUpload(asset))
+
+	if err != nil {
+		errors.HandleAPIError(
+			"Error uploading Component",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	} else if httpResponse.StatusCode != http.StatusNoContent {
+		errors.HandleAPIError(
+			"Upload of Component was not successful",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	// The Components API does not return the uploaded Component, so locate it by checksum and coordinates
+	component, httpResponse, err := r.findUploadedComponent(ctx, plan)
+	if err != nil {
+		errors.HandleAPIError(
+			"Error locating uploaded Component",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	// Update State
+	plan.MapFromApi(component)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *componentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state model.ComponentModelResource
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	ctx = r.AuthContext(ctx)
+
+	// Read API Call
+	apiResponse, httpResponse, err := r.Services.Component.GetComponent(ctx, state.ComponentId.ValueString())
+
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			resp.State.RemoveResource(ctx)
+			errors.HandleAPIWarning(
+				"Component to read did not exist",
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+		} else {
+			errors.HandleAPIError(
+				"Error reading Component",
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+		}
+		return
+	}
+
+	// Update State based on Response
+	state.MapFromApi(apiResponse)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+//
+// Only the path of the local file can change without replacing the Component, so there is
+// nothing to send to Sonatype Nexus Repository.
+func (r *componentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan model.ComponentModelResource
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting plan data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *componentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state model.ComponentModelResource
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting state data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	ctx = r.AuthContext(ctx)
+
+	httpResponse, err := r.Services.Component.DeleteComponent(ctx, state.ComponentId.ValueString())
+
+	// Handle Error
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			errors.HandleAPIWarning(
+				"Component to delete did not exist",
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+		} else {
+			errors.HandleAPIError(
+				"Error removing Component",
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+		}
+		return
+	} else if httpResponse.StatusCode != http.StatusNoContent {
+		errors.HandleAPIError(
+			"Removal of Component was not successful",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+	}
+}
+
+// findUploadedComponent searches the Repository for the Component uploaded for plan, waiting for Sonatype
+// Nexus Repository to index it.
+func (r *componentResource) findUploadedComponent(ctx context.Context, plan model.ComponentModelResource) (*sonatyperepo.ComponentXO, *http.Response, error) {
+	repository := plan.Repository.ValueString()
+	checksum := plan.FileSha256.ValueString()
+	search := common.ComponentSearch{
+		Repository: &repository,
+		Sha256:     &checksum,
+	}
+	if plan.Maven2 != nil {
+		search.Group = plan.Maven2.GroupId.ValueStringPointer()
+		search.Name = plan.Maven2.ArtifactId.ValueStringPointer()
+		search.Version = plan.Maven2.Version.ValueStringPointer()
+	}
+
+	for attempt := 1; attempt <= componentSearchMaxAttempts; attempt++ {
+		components, httpResponse, err := searchAllComponents(ctx, r.Services.Component, search)
+		if err != nil {
+			return nil, httpResponse, err
+		}
+
+		uploaded := make([]sonatyperepo.ComponentXO, 0)
+		for _, c := range components {
+			if plan.IsUploadedComponent(c) {
+				uploaded = append(uploaded, c)
+			}
+		}
+		switch len(uploaded) {
+		case 0:
+			tflog.Debug(ctx, fmt.Sprintf("Uploaded Component not yet searchable (attempt %d of %d)", attempt, componentSearchMaxAttempts))
+			time.Sleep(componentSearchRetryInterval)
+		case 1:
+			return &uploaded[0], httpResponse, nil
+		default:
+			return nil, nil, fmt.Errorf(errComponentAmbiguous, len(uploaded), checksum, repository)
+		}
+	}
+
+	return nil, nil, fmt.Errorf(errComponentNotFound, checksum, repository, componentSearchMaxAttempts)
+}
+
+// componentFormats returns the formats that Components can be uploaded for
+func componentFormats() []string {
+	formats := make([]string, 0)
+	for _, f := range []string{
+		common.REPO_FORMAT_APT,
+		common.REPO_FORMAT_HELM,
+		common.REPO_FORMAT_MAVEN,
+		common.REPO_FORMAT_NPM,
+		common.REPO_FORMAT_NUGET,
+		common.REPO_FORMAT_PYPI,
+		common.REPO_FORMAT_R,
+		common.REPO_FORMAT_RAW,
+		common.REPO_FORMAT_YUM,
+	} {
+		formats = append(formats, strings.ToLower(f))
+	}
+	return formats
+}
+
+// validateFormatBlocks ensures exactly the format specific attributes for the planned format are set
+func validateFormatBlocks(plan *model.ComponentModelResource, respDiags *diag.Diagnostics) {
+	if plan.Format.IsUnknown() {
+		return
+	}
+
+	configured := map[string]bool{
+		"maven2": plan.Maven2 != nil,
+		"r":      plan.R != nil,
+		"raw":    plan.Raw != nil,
+		"yum":    plan.Yum != nil,
+	}
+	for blockFormat, block := range componentFormatBlocks {
+		if blockFormat == plan.Format.ValueString() && !configured[block] {
+			respDiags.AddAttributeError(
+				path.Root(block),
+				"Missing format specific attribute",
+				fmt.Sprintf(errComponentFormatBlockRequired, block, blockFormat),
+			)
+		} else if blockFormat != plan.Format.ValueString() && configured[block] {
+			respDiags.AddAttributeError(
+				path.Root(block),
+				"Invalid format specific attribute",
+				fmt.Sprintf(errComponentFormatBlockInvalid, block, blockFormat),
+			)
+		}
+	}
+}
+
+// fileSha256 returns the hex encoded SHA-256 checksum of a local file
+func fileSha256(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer func() { _ = f.Close() }()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	utils_test "terraform-provider-sonatyperepo/internal/provider/utils"
+)
+
+const (
+	resourceTypeComponent = "sonatyperepo_component"
+)
+
+func TestAccComponentResourceRaw(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceName := fmt.Sprintf("%s.test", resourceTypeComponent)
+	filePath := filepath.Join(t.TempDir(), "component.txt")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				PreConfig: func() { writeComponentFile(t, filePath, "first "+randomString) },
+				Config:    getTestAccComponentResourceRawConfig(randomString, filePath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "repository", fmt.Sprintf("raw-component-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "format", "raw"),
+					resource.TestCheckResourceAttr(resourceName, "file_sha256", sha256Of("first "+randomString)),
+					resource.TestCheckResourceAttr(resourceName, "asset_path", "test/component.txt"),
+					resource.TestCheckResourceAttrSet(resourceName, "component_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "download_url"),
+				),
+			},
+			// Changing the file content replaces the Component
+			{
+				PreConfig: func() { writeComponentFile(t, filePath, "second "+randomString) },
+				Config:    getTestAccComponentResourceRawConfig(randomString, filePath),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "file_sha256", sha256Of("second "+randomString)),
+					resource.TestCheckResourceAttrSet(resourceName, "component_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccComponentResourceMissingFormatAttributes(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "component.txt")
+	writeComponentFile(t, filePath, "content")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test" {
+  repository = "does-not-matter"
+  format     = "raw"
+  file       = "%s"
+}
+`, resourceTypeComponent, filePath),
+				ExpectError: regexp.MustCompile("Attribute 'raw' is required when format is 'raw'"),
+			},
+		},
+	})
+}
+
+func TestAccComponentResourceMissingFile(t *testing.T) {
+	config := fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test" {
+  repository = "does-not-matter"
+  format     = "npm"
+  file       = "%s"
+}
+`, resourceTypeComponent, filepath.Join(t.TempDir(), "does-not-exist.tgz"))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The file may be created during apply, so planning succeeds
+			{
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config:      config,
+				ExpectError: regexp.MustCompile("Error reading file to upload"),
+			},
+		},
+	})
+}
+
+func getTestAccComponentResourceRawConfig(randomString, filePath string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatyperepo_repository_raw_hosted" "repo" {
+  name = "raw-component-%s"
+  online = true
+  storage = {
+    blob_store_name = "default"
+    strict_content_type_validation = false
+    write_policy = "ALLOW"
+  }
+}
+
+resource "%s" "test" {
+  repository = sonatyperepo_repository_raw_hosted.repo.name
+  format     = "raw"
+  file       = "%s"
+  raw = {
+    directory = "test"
+    filename  = "component.txt"
+  }
+}
+`, randomString, resourceTypeComponent, filePath)
+}
+
+func writeComponentFile(t *testing.T, filePath, content string) {
+	t.Helper()
+	if err := os.WriteFile(filePath, []byte(content), 0o600); err != nil {
+		t.Fatalf("unable to write component file: %v", err)
+	}
+}
+
+func sha256Of(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &componentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &componentsDataSource{}
+)
+
+// ComponentsDataSource is a helper function to simplify the provider implementation.
+func ComponentsDataSource() datasource.DataSource {
+	return &componentsDataSource{}
+}
+
+// componentsDataSource is the data source implementation.
+type componentsDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *componentsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_components"
+}
+
+// Schema defines the schema for the data source.
+func (d *componentsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := searchFilterAttributes()
+	attributes["components"] = schema.DataSourceComputedListNestedAttribute(
+		"Components matching the search criteria",
+		tfschema.NestedAttributeObject{
+			Attributes: map[string]tfschema.Attribute{
+				"id":         schema.DataSourceComputedString("ID of the Component"),
+				"repository": schema.DataSourceComputedString("Repository containing the Component"),
+				"format":     schema.DataSourceComputedString("Format of the Component"),
+				"group":      schema.DataSourceComputedString("Group of the Component"),
+				"name":       schema.DataSourceComputedString("Name of the Component"),
+				"version":    schema.DataSourceComputedString("Version of the Component"),
+				"assets": schema.DataSourceComputedListNestedAttribute(
+					"Assets of the Component",
+					tfschema.NestedAttributeObject{
+						Attributes: assetAttributes(),
+					},
+				),
+			},
+		},
+	)
+
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to search for Components using the Search API",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *componentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state model.ComponentsModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = d.AuthContext(ctx)
+
+	results, httpResponse, err := searchAllComponents(ctx, d.Services.Component, state.MapToSearch())
+	if err != nil {
+		errors.HandleAPIError(
+			"Unable to search Components",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Found %d Components", len(results)))
+
+	state.Components = make([]model.ComponentModel, 0, len(results))
+	for _, c := range results {
+		component := model.ComponentModel{}
+		component.MapFromApi(&c)
+		state.Components = append(state.Components, component)
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
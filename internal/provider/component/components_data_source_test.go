@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	utils_test "terraform-provider-sonatyperepo/internal/provider/utils"
+)
+
+func TestAccComponentsDataSource(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	filePath := filepath.Join(t.TempDir(), "component.txt")
+	writeComponentFile(t, filePath, "search "+randomString)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getTestAccComponentResourceRawConfig(randomString, filePath) + `
+data "sonatyperepo_components" "by_repository" {
+  repository = sonatyperepo_component.test.repository
+}
+
+data "sonatyperepo_assets" "by_checksum" {
+  sha256 = sonatyperepo_component.test.file_sha256
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonatyperepo_components.by_repository", "components.#", "1"),
+					resource.TestCheckResourceAttr("data.sonatyperepo_components.by_repository", "components.0.repository", fmt.Sprintf("raw-component-%s", randomString)),
+					resource.TestCheckResourceAttr("data.sonatyperepo_components.by_repository", "components.0.assets.#", "1"),
+					resource.TestCheckResourceAttr("data.sonatyperepo_components.by_repository", "components.0.assets.0.sha256", sha256Of("search "+randomString)),
+					resource.TestCheckResourceAttr("data.sonatyperepo_assets.by_checksum", "assets.#", "1"),
+					resource.TestCheckResourceAttr("data.sonatyperepo_assets.by_checksum", "assets.0.path", "test/component.txt"),
+					resource.TestCheckResourceAttrSet("data.sonatyperepo_assets.by_checksum", "assets.0.download_url"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccComponentsDataSourceNoResults(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: utils_test.ProviderConfig + `
+data "sonatyperepo_components" "none" {
+  name      = "does-not-exist-anywhere"
+  sort      = "version"
+  direction = "desc"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonatyperepo_components.none", "components.#", "0"),
+				),
+			},
+		},
+	})
+}
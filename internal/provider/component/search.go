@@ -0,0 +1,120 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+
+	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+const errSearchContinuationTokenRepeated = "Search API returned continuation token '%s' more than once"
+
+// searchAllComponents follows continuation tokens until every Component matching search has been read
+func searchAllComponents(ctx context.Context, service common.ComponentService, search common.ComponentSearch) ([]sonatyperepo.ComponentXO, *http.Response, error) {
+	components := make([]sonatyperepo.ComponentXO, 0)
+	seenTokens := make(map[string]bool)
+
+	var continuationToken *string
+	for {
+		page, httpResponse, err := service.SearchComponents(ctx, search, continuationToken)
+		if err != nil {
+			return nil, httpResponse, err
+		}
+		components = append(components, page.Items...)
+
+		if page.ContinuationToken == nil || *page.ContinuationToken == "" {
+			return components, httpResponse, nil
+		}
+		if seenTokens[*page.ContinuationToken] {
+			return nil, nil, fmt.Errorf(errSearchContinuationTokenRepeated, *page.ContinuationToken)
+		}
+		seenTokens[*page.ContinuationToken] = true
+		continuationToken = page.ContinuationToken
+		tflog.Debug(ctx, fmt.Sprintf("Read %d Components, continuing search", len(components)))
+	}
+}
+
+// searchAllAssets follows continuation tokens until every Asset matching search has been read
+func searchAllAssets(ctx context.Context, service common.ComponentService, search common.ComponentSearch) ([]sonatyperepo.AssetXO, *http.Response, error) {
+	assets := make([]sonatyperepo.AssetXO, 0)
+	seenTokens := make(map[string]bool)
+
+	var continuationToken *string
+	for {
+		page, httpResponse, err := service.SearchAssets(ctx, search, continuationToken)
+		if err != nil {
+			return nil, httpResponse, err
+		}
+		assets = append(assets, page.Items...)
+
+		if page.ContinuationToken == nil || *page.ContinuationToken == "" {
+			return assets, httpResponse, nil
+		}
+		if seenTokens[*page.ContinuationToken] {
+			return nil, nil, fmt.Errorf(errSearchContinuationTokenRepeated, *page.ContinuationToken)
+		}
+		seenTokens[*page.ContinuationToken] = true
+		continuationToken = page.ContinuationToken
+		tflog.Debug(ctx, fmt.Sprintf("Read %d Assets, continuing search", len(assets)))
+	}
+}
+
+// searchFilterAttributes are the Search API criteria shared by the Component and Asset data sources
+func searchFilterAttributes() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{
+		"repository": schema.DataSourceOptionalString("Only include results from this Repository"),
+		"format":     schema.DataSourceOptionalString("Only include results of this format"),
+		"group":      schema.DataSourceOptionalString("Only include results in this Component group - supports trailing wildcards"),
+		"name":       schema.DataSourceOptionalString("Only include results with this Component name - supports trailing wildcards"),
+		"version":    schema.DataSourceOptionalString("Only include results with this Component version - supports trailing wildcards"),
+		"prerelease": schema.DataSourceOptionalBool("Whether to only include (true) or exclude (false) prerelease versions"),
+		"sha1":       schema.DataSourceOptionalString("Only include results with an asset matching this SHA-1 checksum"),
+		"sha256":     schema.DataSourceOptionalString("Only include results with an asset matching this SHA-256 checksum"),
+		"sha512":     schema.DataSourceOptionalString("Only include results with an asset matching this SHA-512 checksum"),
+		"md5":        schema.DataSourceOptionalString("Only include results with an asset matching this MD5 checksum"),
+		"sort":       schema.DataSourceOptionalStringEnum("Field to sort results by", "group", "name", "version", "repository"),
+		"direction":  schema.DataSourceOptionalStringEnum("Direction to sort results in", "asc", "desc"),
+	}
+}
+
+// assetAttributes are the attributes of an Asset returned by the Search API
+func assetAttributes() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{
+		"id":            schema.DataSourceComputedString("ID of the Asset"),
+		"repository":    schema.DataSourceComputedString("Repository containing the Asset"),
+		"format":        schema.DataSourceComputedString("Format of the Asset"),
+		"path":          schema.DataSourceComputedString("Path of the Asset"),
+		"download_url":  schema.DataSourceComputedString("Download URL of the Asset"),
+		"content_type":  schema.DataSourceComputedString("Content type of the Asset"),
+		"file_size":     schema.DataSourceComputedInt64("Size of the Asset in bytes"),
+		"last_modified": schema.DataSourceComputedString("When the Asset was last modified"),
+		"sha1":          schema.DataSourceComputedString("SHA-1 checksum of the Asset"),
+		"sha256":        schema.DataSourceComputedString("SHA-256 checksum of the Asset"),
+		"sha512":        schema.DataSourceComputedString("SHA-512 checksum of the Asset"),
+		"md5":           schema.DataSourceComputedString("MD5 checksum of the Asset"),
+	}
+}
@@ -0,0 +1,230 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package component
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedComponentService serves pages keyed by the continuation token used to request them
+type pagedComponentService struct {
+	common.ComponentService
+	componentPages map[string]sonatyperepo.PageComponentXO
+	assetPages     map[string]sonatyperepo.PageAssetXO
+	requestedWith  []string
+}
+
+func (s *pagedComponentService) SearchComponents(_ context.Context, _ common.ComponentSearch, continuationToken *string) (*sonatyperepo.PageComponentXO, *http.Response, error) {
+	token := ""
+	if continuationToken != nil {
+		token = *continuationToken
+	}
+	s.requestedWith = append(s.requestedWith, token)
+	page := s.componentPages[token]
+	return &page, &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func (s *pagedComponentService) SearchAssets(_ context.Context, _ common.ComponentSearch, continuationToken *string) (*sonatyperepo.PageAssetXO, *http.Response, error) {
+	token := ""
+	if continuationToken != nil {
+		token = *continuationToken
+	}
+	s.requestedWith = append(s.requestedWith, token)
+	page := s.assetPages[token]
+	return &page, &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func stringPointer(s string) *string {
+	return &s
+}
+
+func TestSearchAllComponentsFollowsContinuationTokens(t *testing.T) {
+	service := &pagedComponentService{
+		componentPages: map[string]sonatyperepo.PageComponentXO{
+			"": {
+				Items:             []sonatyperepo.ComponentXO{{Id: stringPointer("a")}, {Id: stringPointer("b")}},
+				ContinuationToken: stringPointer("page-2"),
+			},
+			"page-2": {
+				Items:             []sonatyperepo.ComponentXO{{Id: stringPointer("c")}},
+				ContinuationToken: stringPointer("page-3"),
+			},
+			"page-3": {
+				Items: []sonatyperepo.ComponentXO{{Id: stringPointer("d")}},
+			},
+		},
+	}
+
+	components, _, err := searchAllComponents(context.Background(), service, common.ComponentSearch{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", "page-2", "page-3"}, service.requestedWith)
+	ids := make([]string, 0)
+	for _, c := range components {
+		ids = append(ids, c.GetId())
+	}
+	assert.Equal(t, []string{"a", "b", "c", "d"}, ids)
+}
+
+func TestSearchAllComponentsNoResults(t *testing.T) {
+	service := &pagedComponentService{
+		componentPages: map[string]sonatyperepo.PageComponentXO{},
+	}
+
+	components, _, err := searchAllComponents(context.Background(), service, common.ComponentSearch{})
+
+	assert.NoError(t, err)
+	assert.Empty(t, components)
+	assert.Equal(t, []string{""}, service.requestedWith)
+}
+
+func TestSearchAllAssetsFollowsContinuationTokens(t *testing.T) {
+	service := &pagedComponentService{
+		assetPages: map[string]sonatyperepo.PageAssetXO{
+			"": {
+				Items:             []sonatyperepo.AssetXO{{Id: stringPointer("a")}},
+				ContinuationToken: stringPointer("page-2"),
+			},
+			"page-2": {
+				Items:             []sonatyperepo.AssetXO{{Id: stringPointer("b")}},
+				ContinuationToken: stringPointer(""),
+			},
+		},
+	}
+
+	assets, _, err := searchAllAssets(context.Background(), service, common.ComponentSearch{})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"", "page-2"}, service.requestedWith)
+	assert.Len(t, assets, 2)
+}
+
+func TestSearchAllAssetsRepeatedContinuationToken(t *testing.T) {
+	service := &pagedComponentService{
+		assetPages: map[string]sonatyperepo.PageAssetXO{
+			"": {
+				Items:             []sonatyperepo.AssetXO{{Id: stringPointer("a")}},
+				ContinuationToken: stringPointer("loop"),
+			},
+			"loop": {
+				Items:             []sonatyperepo.AssetXO{{Id: stringPointer("b")}},
+				ContinuationToken: stringPointer("loop"),
+			},
+		},
+	}
+
+	_, _, err := searchAllAssets(context.Background(), service, common.ComponentSearch{})
+
+	assert.ErrorContains(t, err, "continuation token 'loop' more than once")
+}
+
+func uploadedComponent(id, group, name, version, assetPath, sha256 string) sonatyperepo.ComponentXO {
+	return sonatyperepo.ComponentXO{
+		Id:      stringPointer(id),
+		Group:   stringPointer(group),
+		Name:    stringPointer(name),
+		Version: stringPointer(version),
+		Assets: []sonatyperepo.AssetXO{{
+			Path:     stringPointer(assetPath),
+			Checksum: map[string]interface{}{"sha256": sha256},
+		}},
+	}
+}
+
+func TestFindUploadedComponent(t *testing.T) {
+	rawPlan := model.ComponentModelResource{
+		Repository: types.StringValue("raw-hosted"),
+		FileSha256: types.StringValue("abc"),
+		Raw: &model.ComponentRawModel{
+			Directory: types.StringValue("/releases"),
+			Filename:  types.StringValue("app.zip"),
+		},
+	}
+	mavenPlan := model.ComponentModelResource{
+		Repository: types.StringValue("maven-releases"),
+		FileSha256: types.StringValue("abc"),
+		Maven2: &model.ComponentMaven2Model{
+			GroupId:    types.StringValue("org.example"),
+			ArtifactId: types.StringValue("app"),
+			Version:    types.StringValue("1.0.0"),
+		},
+	}
+
+	testCases := []struct {
+		name        string
+		plan        model.ComponentModelResource
+		components  []sonatyperepo.ComponentXO
+		expectId    string
+		expectError bool
+	}{
+		{
+			name: "raw ignores the same content in another directory",
+			plan: rawPlan,
+			components: []sonatyperepo.ComponentXO{
+				uploadedComponent("copy", "/snapshots", "snapshots/app.zip", "", "snapshots/app.zip", "abc"),
+				uploadedComponent("uploaded", "/releases", "releases/app.zip", "", "releases/app.zip", "abc"),
+			},
+			expectId: "uploaded",
+		},
+		{
+			name: "maven2 ignores the same content at other coordinates",
+			plan: mavenPlan,
+			components: []sonatyperepo.ComponentXO{
+				uploadedComponent("copy", "org.example", "app", "0.9.0", "org/example/app/0.9.0/app-0.9.0.jar", "abc"),
+				uploadedComponent("uploaded", "org.example", "app", "1.0.0", "org/example/app/1.0.0/app-1.0.0.jar", "abc"),
+			},
+			expectId: "uploaded",
+		},
+		{
+			name: "more than one match is an error",
+			plan: mavenPlan,
+			components: []sonatyperepo.ComponentXO{
+				uploadedComponent("a", "org.example", "app", "1.0.0", "org/example/app/1.0.0/app-1.0.0.jar", "abc"),
+				uploadedComponent("b", "org.example", "app", "1.0.0", "org/example/app/1.0.0/app-1.0.0-sources.jar", "abc"),
+			},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &componentResource{}
+			r.Services.Component = &pagedComponentService{
+				componentPages: map[string]sonatyperepo.PageComponentXO{"": {Items: tc.components}},
+			}
+
+			component, _, err := r.findUploadedComponent(context.Background(), tc.plan)
+
+			if tc.expectError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expectId, component.GetId())
+		})
+	}
+}
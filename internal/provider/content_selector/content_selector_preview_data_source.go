@@ -0,0 +1,192 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package content_selector
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/csel"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"terraform-provider-sonatyperepo/internal/provider/validators"
+
+	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &contentSelectorPreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &contentSelectorPreviewDataSource{}
+)
+
+// ContentSelectorPreviewDataSource is a helper function to simplify the provider implementation.
+func ContentSelectorPreviewDataSource() datasource.DataSource {
+	return &contentSelectorPreviewDataSource{}
+}
+
+// contentSelectorPreviewDataSource is the data source implementation.
+type contentSelectorPreviewDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *contentSelectorPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_content_selector_preview"
+}
+
+// Schema defines the schema for the data source.
+func (d *contentSelectorPreviewDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: `Use this data source to preview which Assets a Content Selector expression matches.
+
+Supply either an expression, or the name of an existing Content Selector. By default every Repository is previewed - limit this to a single Repository, or to every Repository of a format.
+
+**Note:** this uses ` + "`POST /service/rest/internal/ui/content-selectors/preview`" + ` - the endpoint the Sonatype Nexus Repository UI previews Content Selectors with, authenticating with the provider's username and password. It is not part of the public REST API, so may change or be removed without notice - it is supported for Sonatype Nexus Repository 3.82.0 to 3.95.1. Servers without it fail with a "not supported" error.`,
+		Attributes: map[string]tfschema.Attribute{
+			"expression": func() tfschema.StringAttribute {
+				attr := schema.DataSourceOptionalString("The Content Selector expression to preview - set when `content_selector` is given.")
+				attr.Computed = true
+				attr.Validators = append(attr.Validators, validators.ContentSelectorExpression())
+				return attr
+			}(),
+			"content_selector": schema.DataSourceOptionalString("The name of an existing Content Selector whose expression to preview."),
+			"repository":       schema.DataSourceOptionalString("Only preview Assets in this Repository."),
+			"format":           schema.DataSourceOptionalString("Only preview Assets in Repositories of this format."),
+			"asset_count":      schema.DataSourceComputedInt64("Number of Assets matched - this may exceed the number of `assets` returned."),
+			"paths":            schema.DataSourceComputedStringList("Paths of the Assets matched."),
+			"assets": schema.DataSourceComputedListNestedAttribute(
+				"Assets matched.",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"repository": schema.DataSourceComputedString("Repository containing the Asset."),
+						"format":     schema.DataSourceComputedString("Format of the Repository containing the Asset."),
+						"path":       schema.DataSourceComputedString("Path of the Asset."),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *contentSelectorPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state model.ContentSelectorPreviewModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	if state.Expression.IsNull() == state.ContentSelector.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Content Selector preview configuration",
+			"Exactly one of expression or content_selector must be specified",
+		)
+		return
+	}
+	if !state.Repository.IsNull() && !state.Format.IsNull() {
+		resp.Diagnostics.AddError(
+			"Invalid Content Selector preview configuration",
+			"Only one of repository or format may be specified",
+		)
+		return
+	}
+
+	ctx = d.AuthContext(ctx)
+
+	if !state.ContentSelector.IsNull() {
+		contentSelector, httpResponse, err := d.Services.ContentSelector.GetContentSelector(ctx, state.ContentSelector.ValueString())
+		if err != nil {
+			errors.HandleAPIError(
+				fmt.Sprintf("Unable to read Content Selector '%s'", state.ContentSelector.ValueString()),
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+			return
+		}
+		state.Expression = types.StringPointerValue(contentSelector.Expression)
+
+		if err := csel.Validate(state.Expression.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("content_selector"),
+				"Content Selector expression could not be parsed locally",
+				fmt.Sprintf("Content Selector '%s' has an expression this provider cannot parse (%s) - previewing anyway", state.ContentSelector.ValueString(), err),
+			)
+		}
+	}
+
+	result, httpResponse, err := d.Services.ContentSelector.PreviewContentSelector(ctx, state.MapToPreview())
+	if err != nil {
+		d.handlePreviewError(ctx, &state, err, httpResponse, &resp.Diagnostics)
+		return
+	}
+
+	state.MapFromApi(*result)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// handlePreviewError reports a failed preview. The preview endpoint is not part of the public REST API, so a
+// 404 or 405 means this version of Sonatype Nexus Repository does not serve it - unless the 404 is for a
+// Repository that does not exist.
+func (d *contentSelectorPreviewDataSource) handlePreviewError(ctx context.Context, state *model.ContentSelectorPreviewModel, err error, httpResponse *http.Response, respDiags *diag.Diagnostics) {
+	if httpResponse == nil || (httpResponse.StatusCode != http.StatusNotFound && httpResponse.StatusCode != http.StatusMethodNotAllowed) {
+		errors.HandleAPIError("Unable to preview Content Selector", &err, httpResponse, respDiags)
+		return
+	}
+
+	if httpResponse.StatusCode == http.StatusNotFound && !state.Repository.IsNull() {
+		repositories, listResponse, listErr := d.Services.Repository.ListRepositories(ctx)
+		if listErr != nil {
+			errors.HandleAPIError("Unable to preview Content Selector", &listErr, listResponse, respDiags)
+			return
+		}
+		if !slices.ContainsFunc(repositories, func(r sonatyperepo.RepositoryXO) bool { return r.GetName() == state.Repository.ValueString() }) {
+			errors.HandleAPIError(
+				fmt.Sprintf("Repository '%s' does not exist", state.Repository.ValueString()),
+				&err,
+				httpResponse,
+				respDiags,
+			)
+			return
+		}
+	}
+
+	respDiags.AddError(
+		"Content Selector preview is not supported by this version of Sonatype Nexus Repository",
+		fmt.Sprintf("Sonatype Nexus Repository responded %s to the Content Selector preview endpoint, which is part of its UI rather than its public REST API - previewing is supported for Sonatype Nexus Repository 3.82.0 to 3.95.1.", httpResponse.Status),
+	)
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package content_selector_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	utils_test "terraform-provider-sonatyperepo/internal/provider/utils"
+)
+
+const (
+	dataSourceContentSelectorPreview = "data.sonatyperepo_content_selector_preview.preview"
+)
+
+func TestAccContentSelectorPreviewDataSource(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Preview the expression of an existing Content Selector
+			{
+				Config: testAccContentSelectorPreviewDataSourceConfig(randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceContentSelectorPreview, "expression", fmt.Sprintf("format == \"raw\" and path =^ \"/%s/\"", randomString)),
+					resource.TestCheckResourceAttr(dataSourceContentSelectorPreview, "format", "raw"),
+					resource.TestCheckResourceAttr(dataSourceContentSelectorPreview, "asset_count", "0"),
+					resource.TestCheckResourceAttr(dataSourceContentSelectorPreview, "paths.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccContentSelectorPreviewDataSourceInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: utils_test.ProviderConfig + `
+data "sonatyperepo_content_selector_preview" "preview" {
+  expression = "format == \"raw\" or"
+}
+`,
+				ExpectError: regexp.MustCompile("Invalid Content Selector expression"),
+			},
+			{
+				Config: utils_test.ProviderConfig + `
+data "sonatyperepo_content_selector_preview" "preview" {
+  expression = "format == \"raw\""
+  repository = "raw-hosted"
+  format     = "raw"
+}
+`,
+				ExpectError: regexp.MustCompile("Only one of repository or format may be specified"),
+			},
+		},
+	})
+}
+
+func testAccContentSelectorPreviewDataSourceConfig(randomString string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatyperepo_content_selector" "test" {
+	name        = "tf-test-cs-preview-%s"
+	description = "Test content selector for preview data source"
+	expression  = "format == \"raw\" and path =^ \"/%s/\""
+}
+
+data "sonatyperepo_content_selector_preview" "preview" {
+	content_selector = sonatyperepo_content_selector.test.name
+	format           = "raw"
+}
+`, randomString, randomString)
+}
@@ -24,7 +24,9 @@ import (
 	"time"
 
 	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/dependency"
 	"terraform-provider-sonatyperepo/internal/provider/model"
+	"terraform-provider-sonatyperepo/internal/provider/validators"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -64,8 +66,11 @@ func (r *contentSelectorResource) Schema(_ context.Context, _ resource.SchemaReq
 				regexp.MustCompile(contentSelectorNamePattern),
 				fmt.Sprintf("Content Selector name must match pattern %s`", contentSelectorNamePattern),
 			),
-			"description":  schema.ResourceRequiredString("The description of this Content Selector."),
-			"expression":   schema.ResourceRequiredString("The Content Selector expression used to identify content."),
+			"description": schema.ResourceRequiredString("The description of this Content Selector."),
+			"expression": schema.ResourceRequiredStringWithValidators(
+				"The Content Selector expression used to identify content.",
+				validators.ContentSelectorExpression(),
+			),
 			"last_updated": schema.ResourceLastUpdated(),
 		},
 	}
@@ -218,6 +223,10 @@ func (r *contentSelectorResource) Delete(ctx context.Context, req resource.Delet
 
 	ctx = r.AuthContext(ctx)
 
+	if !dependency.GuardDelete(ctx, r.Services, dependency.KIND_CONTENT_SELECTOR, state.Name.ValueString(), false, &resp.Diagnostics) {
+		return
+	}
+
 	httpResponse, err := r.Services.ContentSelector.DeleteContentSelector(ctx, state.Name.ValueString())
 
 	// Handle Error
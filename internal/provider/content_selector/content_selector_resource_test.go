@@ -18,6 +18,7 @@ package content_selector_test
 
 import (
 	"fmt"
+	"regexp"
 	utils_test "terraform-provider-sonatyperepo/internal/provider/utils"
 	"testing"
 
@@ -52,6 +53,20 @@ func TestAccContentSelectorResource(t *testing.T) {
 
 }
 
+func TestAccContentSelectorResourceInvalidExpression(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      contentSelectorResourceInvalidExpressionConfig(randomString),
+				ExpectError: regexp.MustCompile(`column 8: expected one of the operators ==, =~ or =\^`),
+			},
+		},
+	})
+}
+
 func contentSelectorResourceConfig(randomString string) string {
 	return fmt.Sprintf(utils_test.ProviderConfig+`
 resource "%s" "cs" {
@@ -61,3 +76,13 @@ resource "%s" "cs" {
 }
 `, resourceTypeContentSelector, randomString, randomString)
 }
+
+func contentSelectorResourceInvalidExpressionConfig(randomString string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "cs" {
+  name = "test-content-selector-%s"
+  description = "This is an invalid test content selector"
+  expression = "format = \"maven2\""
+}
+`, resourceTypeContentSelector, randomString)
+}
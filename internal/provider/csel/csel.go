@@ -0,0 +1,287 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package csel parses Content Selector Expression Language (CSEL) expressions, so that invalid
+// Content Selector expressions are reported during `terraform validate` rather than when applied.
+//
+// The grammar mirrors what Sonatype Nexus Repository accepts:
+//
+//	expression := term ( "or" term )*
+//	term       := factor ( "and" factor )*
+//	factor     := "(" expression ")" | identifier operator string
+//	identifier := "format" | "path" | "coordinate." name
+//	operator   := "==" | "=~" | "=^"
+package csel
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+const (
+	IDENTIFIER_FORMAT            = "format"
+	IDENTIFIER_PATH              = "path"
+	IDENTIFIER_COORDINATE_PREFIX = "coordinate."
+)
+
+// SyntaxError describes why an expression is invalid, and where - Line and Column are 1-based and
+// count characters, not bytes.
+type SyntaxError struct {
+	Line    int
+	Column  int
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Line > 1 {
+		return fmt.Sprintf("line %d, column %d: %s", e.Line, e.Column, e.Message)
+	}
+	return fmt.Sprintf("column %d: %s", e.Column, e.Message)
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenString
+	tokenOperator
+	tokenAnd
+	tokenOr
+	tokenLeftParen
+	tokenRightParen
+)
+
+type token struct {
+	kind   tokenKind
+	text   string
+	line   int
+	column int
+}
+
+func (t token) describe() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of expression"
+	case tokenString:
+		return fmt.Sprintf("string %s", t.text)
+	}
+	return fmt.Sprintf("'%s'", t.text)
+}
+
+// Validate parses the expression, returning a *SyntaxError if it is not valid CSEL.
+func Validate(expression string) error {
+	tokens, err := tokenize(expression)
+	if err != nil {
+		return err
+	}
+	if len(tokens) == 1 {
+		return &SyntaxError{Line: 1, Column: 1, Message: "expression is empty"}
+	}
+
+	p := &parser{tokens: tokens}
+	if err := p.parseExpression(); err != nil {
+		return err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return p.unexpected(t, "'and', 'or' or end of expression")
+	}
+	return nil
+}
+
+// tokenize splits the expression into tokens, ending with tokenEOF.
+func tokenize(expression string) ([]token, error) {
+	runes := []rune(expression)
+	tokens := make([]token, 0)
+	line, column := 1, 1
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		start := token{line: line, column: column}
+
+		switch {
+		case r == '\n':
+			i++
+			line++
+			column = 1
+			continue
+
+		case unicode.IsSpace(r):
+			i++
+			column++
+			continue
+
+		case r == '(' || r == ')':
+			start.kind = tokenLeftParen
+			if r == ')' {
+				start.kind = tokenRightParen
+			}
+			start.text = string(r)
+			i++
+			column++
+
+		case r == '=':
+			if i+1 >= len(runes) || !strings.ContainsRune("=~^", runes[i+1]) {
+				return nil, &SyntaxError{Line: line, Column: column, Message: "expected one of the operators ==, =~ or =^"}
+			}
+			start.kind = tokenOperator
+			start.text = string(runes[i : i+2])
+			i += 2
+			column += 2
+
+		case r == '"' || r == '\'':
+			end := i + 1
+			for ; end < len(runes) && runes[end] != r; end++ {
+				if runes[end] == '\\' {
+					end++
+				}
+				if end < len(runes) && runes[end] == '\n' {
+					return nil, &SyntaxError{Line: line, Column: column, Message: "string is not terminated before end of line"}
+				}
+			}
+			if end >= len(runes) {
+				return nil, &SyntaxError{Line: line, Column: column, Message: "string is not terminated"}
+			}
+			start.kind = tokenString
+			start.text = string(runes[i : end+1])
+			column += end + 1 - i
+			i = end + 1
+
+		case isIdentifierRune(r):
+			end := i
+			for end < len(runes) && isIdentifierRune(runes[end]) {
+				end++
+			}
+			start.text = string(runes[i:end])
+			switch start.text {
+			case "and":
+				start.kind = tokenAnd
+			case "or":
+				start.kind = tokenOr
+			default:
+				start.kind = tokenIdentifier
+			}
+			column += end - i
+			i = end
+
+		default:
+			return nil, &SyntaxError{Line: line, Column: column, Message: fmt.Sprintf("unexpected character '%c'", r)}
+		}
+
+		tokens = append(tokens, start)
+	}
+
+	return append(tokens, token{kind: tokenEOF, line: line, column: column}), nil
+}
+
+func isIdentifierRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' || r == '.' || r == '-'
+}
+
+// parser is a recursive descent parser over the tokens of an expression.
+type parser struct {
+	tokens   []token
+	position int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.position]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.position]
+	if t.kind != tokenEOF {
+		p.position++
+	}
+	return t
+}
+
+func (p *parser) unexpected(t token, expected string) *SyntaxError {
+	return &SyntaxError{
+		Line:    t.line,
+		Column:  t.column,
+		Message: fmt.Sprintf("expected %s but found %s", expected, t.describe()),
+	}
+}
+
+func (p *parser) parseExpression() error {
+	if err := p.parseTerm(); err != nil {
+		return err
+	}
+	for p.peek().kind == tokenOr {
+		p.next()
+		if err := p.parseTerm(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *parser) parseTerm() error {
+	if err := p.parseFactor(); err != nil {
+		return err
+	}
+	for p.peek().kind == tokenAnd {
+		p.next()
+		if err := p.parseFactor(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *parser) parseFactor() error {
+	t := p.next()
+	switch t.kind {
+	case tokenLeftParen:
+		if err := p.parseExpression(); err != nil {
+			return err
+		}
+		if closing := p.next(); closing.kind != tokenRightParen {
+			return p.unexpected(closing, "')'")
+		}
+		return nil
+
+	case tokenIdentifier:
+		if !isAllowedIdentifier(t.text) {
+			return &SyntaxError{
+				Line:    t.line,
+				Column:  t.column,
+				Message: fmt.Sprintf("unknown identifier '%s' - expected format, path or coordinate.<name>", t.text),
+			}
+		}
+		if operator := p.next(); operator.kind != tokenOperator {
+			return p.unexpected(operator, "one of the operators ==, =~ or =^")
+		}
+		if value := p.next(); value.kind != tokenString {
+			return p.unexpected(value, "a quoted string")
+		}
+		return nil
+	}
+
+	return p.unexpected(t, "an identifier or '('")
+}
+
+// isAllowedIdentifier is true for the identifiers that Sonatype Nexus Repository allows in CSEL.
+func isAllowedIdentifier(identifier string) bool {
+	switch identifier {
+	case IDENTIFIER_FORMAT, IDENTIFIER_PATH:
+		return true
+	}
+	name, found := strings.CutPrefix(identifier, IDENTIFIER_COORDINATE_PREFIX)
+	return found && name != "" && !strings.Contains(name, ".")
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package csel_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"terraform-provider-sonatyperepo/internal/provider/csel"
+)
+
+func TestValidateValidExpressions(t *testing.T) {
+	expressions := []string{
+		`format == "maven2"`,
+		`format == 'npm' and path =^ "/@scope/"`,
+		`path =~ "^/org/sonatype/.*" or path =~ "^/com/sonatype/.*"`,
+		`format == "maven2" and (coordinate.groupId == "org.example" or coordinate.groupId =^ "org.example.")`,
+		`((format == "raw"))`,
+		`path == "/it\"s"`,
+		"format == \"maven2\"\n  and path =^ \"/org/\"",
+	}
+
+	for _, expression := range expressions {
+		t.Run(expression, func(t *testing.T) {
+			assert.NoError(t, csel.Validate(expression))
+		})
+	}
+}
+
+func TestValidateInvalidExpressions(t *testing.T) {
+	testCases := []struct {
+		expression     string
+		expectedLine   int
+		expectedColumn int
+		expectedError  string
+	}{
+		{"", 1, 1, "expression is empty"},
+		{`format = "maven2"`, 1, 8, "expected one of the operators ==, =~ or =^"},
+		{`format != "maven2"`, 1, 8, "unexpected character '!'"},
+		{`repository == "maven-releases"`, 1, 1, "unknown identifier 'repository'"},
+		{`coordinate. == "x"`, 1, 1, "unknown identifier 'coordinate.'"},
+		{`format == maven2`, 1, 11, "expected a quoted string but found 'maven2'"},
+		{`format == "maven2`, 1, 11, "string is not terminated"},
+		{`format == "maven2" and`, 1, 23, "expected an identifier or '(' but found end of expression"},
+		{`(format == "maven2"`, 1, 20, "expected ')' but found end of expression"},
+		{`format == "maven2")`, 1, 19, "expected 'and', 'or' or end of expression but found ')'"},
+		{`format == "maven2" && path =^ "/"`, 1, 20, "unexpected character '&'"},
+		{`format == "maven2" path =^ "/"`, 1, 20, "expected 'and', 'or' or end of expression but found 'path'"},
+		{"format == \"maven2\"\n  and pth =^ \"/\"", 2, 7, "unknown identifier 'pth'"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			err := csel.Validate(tc.expression)
+
+			var syntaxError *csel.SyntaxError
+			if assert.ErrorAs(t, err, &syntaxError) {
+				assert.Equal(t, tc.expectedLine, syntaxError.Line)
+				assert.Equal(t, tc.expectedColumn, syntaxError.Column)
+				assert.Contains(t, syntaxError.Message, tc.expectedError)
+			}
+		})
+	}
+}
+
+func TestSyntaxErrorString(t *testing.T) {
+	assert.Equal(t, "column 3: bad", (&csel.SyntaxError{Line: 1, Column: 3, Message: "bad"}).Error())
+	assert.Equal(t, "line 2, column 3: bad", (&csel.SyntaxError{Line: 2, Column: 3, Message: "bad"}).Error())
+}
@@ -0,0 +1,246 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dependency
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/privilege/privilege_type"
+	"terraform-provider-sonatyperepo/internal/provider/repository/format"
+
+	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+)
+
+// Kind of object that other objects in Sonatype Nexus Repository may depend upon.
+type Kind int
+
+const (
+	KIND_BLOB_STORE Kind = iota
+	KIND_CLEANUP_POLICY
+	KIND_CONTENT_SELECTOR
+	KIND_ROUTING_RULE
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KIND_BLOB_STORE:
+		return "Blob Store"
+	case KIND_CLEANUP_POLICY:
+		return "Cleanup Policy"
+	case KIND_CONTENT_SELECTOR:
+		return "Content Selector"
+	case KIND_ROUTING_RULE:
+		return "Routing Rule"
+	}
+	return "unknown"
+}
+
+// Detachable is true if references to this Kind of object can be removed from its dependents - a
+// Repository cannot be moved to another Blob Store, Blob Store Group members must be removed through
+// the Blob Store Group, and a Privilege cannot exist without its Content Selector.
+func (k Kind) Detachable() bool {
+	return k == KIND_CLEANUP_POLICY || k == KIND_ROUTING_RULE
+}
+
+// Dependents are the objects in Sonatype Nexus Repository that reference an object.
+type Dependents struct {
+	Repositories    []format.RepositoryUsage
+	Privileges      []string
+	BlobStoreGroups []string
+}
+
+// IsEmpty is true when nothing references the object.
+func (d Dependents) IsEmpty() bool {
+	return len(d.Repositories) == 0 && len(d.Privileges) == 0 && len(d.BlobStoreGroups) == 0
+}
+
+// String lists every dependent, one per line.
+func (d Dependents) String() string {
+	lines := make([]string, 0)
+	for _, r := range d.Repositories {
+		lines = append(lines, fmt.Sprintf("  - Repository '%s'", r.Name))
+	}
+	for _, p := range d.Privileges {
+		lines = append(lines, fmt.Sprintf("  - Privilege '%s'", p))
+	}
+	for _, g := range d.BlobStoreGroups {
+		lines = append(lines, fmt.Sprintf("  - Blob Store Group '%s'", g))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// FindDependents finds every object in Sonatype Nexus Repository that references the named object.
+func FindDependents(ctx context.Context, services common.Services, kind Kind, name string) (Dependents, *http.Response, error) {
+	dependents := Dependents{
+		Repositories:    make([]format.RepositoryUsage, 0),
+		Privileges:      make([]string, 0),
+		BlobStoreGroups: make([]string, 0),
+	}
+
+	switch kind {
+	case KIND_BLOB_STORE, KIND_CLEANUP_POLICY, KIND_ROUTING_RULE:
+		usage, httpResponse, err := format.ListRepositoryUsage(ctx, services.Repository)
+		if err != nil {
+			return dependents, httpResponse, err
+		}
+		switch kind {
+		case KIND_BLOB_STORE:
+			for _, u := range usage {
+				if u.BlobStoreName == name {
+					dependents.Repositories = append(dependents.Repositories, u)
+				}
+			}
+			groups, httpResponse, err := blobStoreGroupsWithMember(ctx, services.BlobStore, name)
+			if err != nil {
+				return dependents, httpResponse, err
+			}
+			dependents.BlobStoreGroups = groups
+		case KIND_CLEANUP_POLICY:
+			dependents.Repositories = format.RepositoriesUsingCleanupPolicy(usage, name)
+		case KIND_ROUTING_RULE:
+			dependents.Repositories = format.RepositoriesUsingRoutingRule(usage, name)
+		}
+
+	case KIND_CONTENT_SELECTOR:
+		privileges, httpResponse, err := services.Privilege.GetAllPrivileges(ctx)
+		if err != nil {
+			return dependents, httpResponse, err
+		}
+		dependents.Privileges = privilegesUsingContentSelector(privileges, name)
+	}
+
+	return dependents, nil, nil
+}
+
+// Detach removes every reference to the named object from its dependents.
+func Detach(ctx context.Context, services common.Services, kind Kind, name string, dependents Dependents) (*http.Response, error) {
+	if !kind.Detachable() {
+		return nil, fmt.Errorf("references to a %s cannot be removed", kind)
+	}
+
+	for _, r := range dependents.Repositories {
+		var httpResponse *http.Response
+		var err error
+		switch kind {
+		case KIND_CLEANUP_POLICY:
+			tflog.Info(ctx, fmt.Sprintf("Removing Cleanup Policy '%s' from Repository '%s'", name, r.Name))
+			httpResponse, err = format.DetachCleanupPolicy(ctx, services.Repository, r, name)
+		case KIND_ROUTING_RULE:
+			tflog.Info(ctx, fmt.Sprintf("Removing Routing Rule '%s' from Repository '%s'", name, r.Name))
+			httpResponse, err = format.DetachRoutingRule(ctx, services.Repository, r)
+		}
+		if err != nil {
+			return httpResponse, err
+		}
+	}
+
+	return nil, nil
+}
+
+// GuardDelete checks that nothing references the named object before it is deleted - returning false, with
+// the dependents listed in an error, if deletion must not go ahead.
+//
+// When forceDetach is true, references are removed from dependents first so that deletion can go ahead.
+func GuardDelete(ctx context.Context, services common.Services, kind Kind, name string, forceDetach bool, diags *diag.Diagnostics) bool {
+	dependents, httpResponse, err := FindDependents(ctx, services, kind, name)
+	if err != nil {
+		errors.HandleAPIError(
+			fmt.Sprintf("Unable to determine what uses %s '%s'", kind, name),
+			&err,
+			httpResponse,
+			diags,
+		)
+		return false
+	}
+	if dependents.IsEmpty() {
+		return true
+	}
+
+	if !forceDetach || !kind.Detachable() {
+		diags.AddError(
+			fmt.Sprintf("%s is in use", kind),
+			fmt.Sprintf("%s '%s' cannot be deleted as it is used by:\n%s\n\n%s", kind, name, dependents, resolution(kind)),
+		)
+		return false
+	}
+
+	httpResponse, err = Detach(ctx, services, kind, name, dependents)
+	if err != nil {
+		errors.HandleAPIError(
+			fmt.Sprintf("Unable to remove references to %s '%s'", kind, name),
+			&err,
+			httpResponse,
+			diags,
+		)
+		return false
+	}
+	return true
+}
+
+// resolution explains how the dependents of an object of this Kind can be dealt with.
+func resolution(kind Kind) string {
+	switch kind {
+	case KIND_BLOB_STORE:
+		return "Delete these Repositories, or remove this Blob Store from these Blob Store Groups, first."
+	case KIND_CONTENT_SELECTOR:
+		return "Delete these Privileges, or change them to use another Content Selector, first."
+	}
+	return "Remove it from these Repositories first, or set `force_detach = true` to have it removed automatically."
+}
+
+// blobStoreGroupsWithMember returns the names of the Blob Store Groups that the named Blob Store is a member of.
+func blobStoreGroupsWithMember(ctx context.Context, blobStoreService common.BlobStoreService, blobStoreName string) ([]string, *http.Response, error) {
+	blobStores, httpResponse, err := blobStoreService.ListBlobStores(ctx)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	groups := make([]string, 0)
+	for _, b := range blobStores {
+		if !strings.EqualFold(b.GetType(), common.BLOB_STORE_TYPE_GROUP) {
+			continue
+		}
+		group, httpResponse, err := blobStoreService.GetGroupBlobStoreConfiguration(ctx, b.GetName())
+		if err != nil {
+			return nil, httpResponse, err
+		}
+		if slices.Contains(group.GetMembers(), blobStoreName) {
+			groups = append(groups, b.GetName())
+		}
+	}
+	return groups, httpResponse, nil
+}
+
+// privilegesUsingContentSelector returns the names of the Privileges that grant access through the named Content Selector.
+func privilegesUsingContentSelector(privileges []sonatyperepo.ApiPrivilegeRequest, contentSelectorName string) []string {
+	names := make([]string, 0)
+	for _, p := range privileges {
+		if p.GetType() == privilege_type.TypeRepositoryContentSelector.String() && p.GetContentSelector() == contentSelectorName {
+			names = append(names, p.GetName())
+		}
+	}
+	return names
+}
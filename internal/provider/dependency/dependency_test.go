@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package dependency
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/repository/format"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/stretchr/testify/assert"
+
+	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+)
+
+// fakePrivilegeService serves a fixed set of Privileges
+type fakePrivilegeService struct {
+	common.PrivilegeService
+	privileges []sonatyperepo.ApiPrivilegeRequest
+}
+
+func (s *fakePrivilegeService) GetAllPrivileges(_ context.Context) ([]sonatyperepo.ApiPrivilegeRequest, *http.Response, error) {
+	return s.privileges, &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func contentSelectorPrivilege(name, contentSelector string) sonatyperepo.ApiPrivilegeRequest {
+	return sonatyperepo.ApiPrivilegeRequest{
+		Name:            name,
+		Type:            "repository-content-selector",
+		ContentSelector: common.StringPointer(contentSelector),
+	}
+}
+
+func TestDependentsString(t *testing.T) {
+	dependents := Dependents{
+		Repositories:    []format.RepositoryUsage{{Name: "maven-releases"}},
+		Privileges:      []string{"read-releases"},
+		BlobStoreGroups: []string{"all-stores"},
+	}
+
+	assert.False(t, dependents.IsEmpty())
+	assert.Equal(t, "  - Repository 'maven-releases'\n  - Privilege 'read-releases'\n  - Blob Store Group 'all-stores'", dependents.String())
+	assert.True(t, Dependents{}.IsEmpty())
+}
+
+func TestKindDetachable(t *testing.T) {
+	assert.False(t, KIND_BLOB_STORE.Detachable())
+	assert.True(t, KIND_CLEANUP_POLICY.Detachable())
+	assert.False(t, KIND_CONTENT_SELECTOR.Detachable())
+	assert.True(t, KIND_ROUTING_RULE.Detachable())
+}
+
+func TestPrivilegesUsingContentSelector(t *testing.T) {
+	privileges := []sonatyperepo.ApiPrivilegeRequest{
+		contentSelectorPrivilege("read-releases", "releases"),
+		contentSelectorPrivilege("read-snapshots", "snapshots"),
+		{Name: "nx-admin", Type: "wildcard"},
+	}
+
+	assert.Equal(t, []string{"read-releases"}, privilegesUsingContentSelector(privileges, "releases"))
+	assert.Empty(t, privilegesUsingContentSelector(privileges, "unused"))
+}
+
+func TestGuardDeleteContentSelector(t *testing.T) {
+	testCases := []struct {
+		name            string
+		contentSelector string
+		forceDetach     bool
+		expectAllowed   bool
+	}{
+		{
+			name:            "unused",
+			contentSelector: "unused",
+			expectAllowed:   true,
+		},
+		{
+			name:            "in use",
+			contentSelector: "releases",
+			expectAllowed:   false,
+		},
+		{
+			name:            "in use with force detach",
+			contentSelector: "releases",
+			forceDetach:     true,
+			expectAllowed:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			privilegeService := &fakePrivilegeService{
+				privileges: []sonatyperepo.ApiPrivilegeRequest{
+					contentSelectorPrivilege("read-releases", "releases"),
+					contentSelectorPrivilege("browse-releases", "releases"),
+				},
+			}
+			services := common.Services{Privilege: privilegeService}
+			var diags diag.Diagnostics
+
+			allowed := GuardDelete(context.Background(), services, KIND_CONTENT_SELECTOR, tc.contentSelector, tc.forceDetach, &diags)
+
+			assert.Equal(t, tc.expectAllowed, allowed)
+			assert.Equal(t, !tc.expectAllowed, diags.HasError())
+			if !tc.expectAllowed {
+				assert.Contains(t, diags.Errors()[0].Detail(), "Privilege 'read-releases'")
+				assert.Contains(t, diags.Errors()[0].Detail(), "Privilege 'browse-releases'")
+				assert.NotContains(t, diags.Errors()[0].Detail(), "force_detach")
+			}
+		})
+	}
+}
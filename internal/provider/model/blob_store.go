@@ -39,6 +39,41 @@ type BlobStoresModel struct {
 	BlobStores []BlobStoreModel `tfsdk:"blob_stores"`
 }
 
+// BlobStoreQuotaStatusModel
+// ------------------------------------
+type BlobStoreQuotaStatusModel struct {
+	Name        types.String `tfsdk:"name"`
+	IsViolation types.Bool   `tfsdk:"is_violation"`
+	Message     types.String `tfsdk:"message"`
+	BlobStoreUsageModel
+}
+
+func (m *BlobStoreQuotaStatusModel) MapFromApi(api *v3.BlobStoreQuotaResultXO) {
+	m.IsViolation = types.BoolValue(api.GetIsViolation())
+	m.Message = types.StringPointerValue(api.Message)
+}
+
+// BlobStoreUsageModel
+// ------------------------------------
+// Quota and usage attributes reported for a single Blob Store
+type BlobStoreUsageModel struct {
+	SoftQuota             *BlobStoreSoftQuota `tfsdk:"soft_quota"`
+	BlobCount             types.Int64         `tfsdk:"blob_count"`
+	TotalSizeInBytes      types.Int64         `tfsdk:"total_size_in_bytes"`
+	AvailableSpaceInBytes types.Int64         `tfsdk:"available_space_in_bytes"`
+}
+
+func (m *BlobStoreUsageModel) MapFromApi(api *v3.GenericBlobStoreApiResponse) {
+	m.SoftQuota = nil
+	if api.SoftQuota != nil && api.SoftQuota.Type != nil {
+		m.SoftQuota = &BlobStoreSoftQuota{}
+		m.SoftQuota.MapFromApi(api.SoftQuota)
+	}
+	m.BlobCount = types.Int64PointerValue(api.BlobCount)
+	m.TotalSizeInBytes = types.Int64PointerValue(api.TotalSizeInBytes)
+	m.AvailableSpaceInBytes = types.Int64PointerValue(api.AvailableSpaceInBytes)
+}
+
 // BlobStoreSoftQuota
 // ------------------------------------
 type BlobStoreSoftQuota struct {
@@ -59,12 +94,35 @@ func (m *BlobStoreSoftQuota) MapToApi(api *v3.BlobStoreApiSoftQuota) {
 	api.Limit = m.Limit.ValueInt64Pointer()
 }
 
+// BlobStoreCommonModelDS
+// ------------------------------------
+// Attributes shared by all data sources for a single Blob Store
+type BlobStoreCommonModelDS struct {
+	Type                types.String   `tfsdk:"type"`
+	Unavailable         types.Bool     `tfsdk:"unavailable"`
+	IncludeRepositories types.Bool     `tfsdk:"include_repositories"`
+	Repositories        []types.String `tfsdk:"repositories"`
+	BlobStoreUsageModel
+}
+
+func (m *BlobStoreCommonModelDS) MapFromApi(api *v3.GenericBlobStoreApiResponse) {
+	m.BlobStoreUsageModel.MapFromApi(api)
+	m.Unavailable = types.BoolPointerValue(api.Unavailable)
+}
+
+func (m *BlobStoreCommonModelDS) MapRepositories(repositoryNames []string) {
+	m.Repositories = make([]types.String, 0, len(repositoryNames))
+	for _, n := range repositoryNames {
+		m.Repositories = append(m.Repositories, types.StringValue(n))
+	}
+}
+
 // BlobStoreFileModel
 // ------------------------------------
 type BlobStoreFileModelDS struct {
-	Name      types.String        `tfsdk:"name"`
-	Path      types.String        `tfsdk:"path"`
-	SoftQuota *BlobStoreSoftQuota `tfsdk:"soft_quota"`
+	BlobStoreCommonModelDS
+	Name types.String `tfsdk:"name"`
+	Path types.String `tfsdk:"path"`
 }
 
 type BlobStoreFileModel struct {
@@ -77,23 +135,25 @@ type BlobStoreFileModel struct {
 // BlobStoreGroupModel
 // ------------------------------------
 type BlobStoreGroupModelDS struct {
-	Name       types.String        `tfsdk:"name"`
-	SoftQuota  *BlobStoreSoftQuota `tfsdk:"soft_quota"`
-	Members    []types.String      `tfsdk:"members"`
-	FillPolicy types.String        `tfsdk:"fill_policy"`
+	BlobStoreCommonModelDS
+	Name       types.String   `tfsdk:"name"`
+	Members    []types.String `tfsdk:"members"`
+	FillPolicy types.String   `tfsdk:"fill_policy"`
 }
 
 type BlobStoreGroupModel struct {
-	Name        types.String        `tfsdk:"name"`
-	SoftQuota   *BlobStoreSoftQuota `tfsdk:"soft_quota"`
-	Members     []types.String      `tfsdk:"members"`
-	FillPolicy  types.String        `tfsdk:"fill_policy"`
-	LastUpdated types.String        `tfsdk:"last_updated"`
+	Name              types.String        `tfsdk:"name"`
+	SoftQuota         *BlobStoreSoftQuota `tfsdk:"soft_quota"`
+	Members           []types.String      `tfsdk:"members"`
+	FillPolicy        types.String        `tfsdk:"fill_policy"`
+	OriginalRenamedTo types.String        `tfsdk:"original_renamed_to"`
+	LastUpdated       types.String        `tfsdk:"last_updated"`
 }
 
 func (m *BlobStoreGroupModel) MapFromApi(api *v3.GroupBlobStoreApiModel) {
 	// Name is not in API response
 	m.FillPolicy = types.StringPointerValue(api.FillPolicy)
+	m.SoftQuota = nil
 	if api.SoftQuota != nil {
 		m.SoftQuota = &BlobStoreSoftQuota{}
 		m.SoftQuota.MapFromApi(api.SoftQuota)
@@ -135,9 +195,8 @@ func (m *BlobStoreGroupModel) mapCommonGroupFields(api interface {
 // BlobStoreS3Model
 // ------------------------------------
 type BlobStoreS3ModelDS struct {
+	BlobStoreCommonModelDS
 	Name                types.String                         `tfsdk:"name"`
-	Type                types.String                         `tfsdk:"type"`
-	SoftQuota           *BlobStoreSoftQuota                  `tfsdk:"soft_quota"`
 	BucketConfiguration *BlobStoreS3BucketConfigurationModel `tfsdk:"bucket_configuration"`
 }
 
@@ -196,6 +255,7 @@ type BlobStoreS3BucketConfigurationModelV1 struct {
 	BucketSecurity           *BlobStoreS3BucketSecurityModel           `tfsdk:"bucket_security"`
 	AdvancedBucketConnection *BlobStoreS3AdvancedBucketConnectionModel `tfsdk:"advanced_bucket_connection"`
 	PreSignedUrlEnabled      types.Bool                                `tfsdk:"pre_signed_url_enabled"`
+	FailoverBuckets          []BlobStoreS3FailoverBucketModel          `tfsdk:"failover_buckets"`
 }
 type BlobStoreS3BucketConfigurationModel = BlobStoreS3BucketConfigurationModelV1
 
@@ -224,6 +284,12 @@ func (m *BlobStoreS3BucketConfigurationModel) MapFromApi(api *v3.S3BlobStoreApiB
 	} else {
 		m.PreSignedUrlEnabled = types.BoolPointerValue(api.PreSignedUrlEnabled)
 	}
+	m.FailoverBuckets = nil
+	for _, f := range api.FailoverBuckets {
+		failoverBucket := BlobStoreS3FailoverBucketModel{}
+		failoverBucket.MapFromApi(&f)
+		m.FailoverBuckets = append(m.FailoverBuckets, failoverBucket)
+	}
 }
 
 func (m *BlobStoreS3BucketConfigurationModel) MapToApi(api *v3.S3BlobStoreApiBucketConfiguration) {
@@ -244,6 +310,31 @@ func (m *BlobStoreS3BucketConfigurationModel) MapToApi(api *v3.S3BlobStoreApiBuc
 		m.AdvancedBucketConnection.MapToApi(api.AdvancedBucketConnection)
 	}
 	api.PreSignedUrlEnabled = util.BoolToPtr(m.PreSignedUrlEnabled.ValueBool())
+	if len(m.FailoverBuckets) > 0 {
+		api.FailoverBuckets = make([]v3.S3BlobStoreApiFailoverBucket, 0, len(m.FailoverBuckets))
+		for _, f := range m.FailoverBuckets {
+			failoverBucket := v3.S3BlobStoreApiFailoverBucket{}
+			f.MapToApi(&failoverBucket)
+			api.FailoverBuckets = append(api.FailoverBuckets, failoverBucket)
+		}
+	}
+}
+
+// BlobStoreS3FailoverBucketModel
+// ------------------------------------
+type BlobStoreS3FailoverBucketModel struct {
+	Region     types.String `tfsdk:"region"`
+	BucketName types.String `tfsdk:"bucket_name"`
+}
+
+func (m *BlobStoreS3FailoverBucketModel) MapFromApi(api *v3.S3BlobStoreApiFailoverBucket) {
+	m.Region = types.StringValue(api.Region)
+	m.BucketName = types.StringValue(api.BucketName)
+}
+
+func (m *BlobStoreS3FailoverBucketModel) MapToApi(api *v3.S3BlobStoreApiFailoverBucket) {
+	api.Region = m.Region.ValueString()
+	api.BucketName = m.BucketName.ValueString()
 }
 
 // BlobStoreS3BucketModel
@@ -347,6 +438,51 @@ type BlobStoreGoogleCloudModel struct {
 	LastUpdated         types.String                             `tfsdk:"last_updated"`
 }
 
+// BlobStoreGoogleCloudModelDS
+// ------------------------------------
+type BlobStoreGoogleCloudModelDS struct {
+	BlobStoreCommonModelDS
+	Name                types.String                               `tfsdk:"name"`
+	BucketConfiguration *BlobStoreGoogleCloudBucketConfigurationDS `tfsdk:"bucket_configuration"`
+}
+
+func (m *BlobStoreGoogleCloudModelDS) MapFromApi(api *v3.GoogleCloudBlobstoreApiModel) {
+	m.Name = types.StringValue(api.Name)
+	m.BucketConfiguration = &BlobStoreGoogleCloudBucketConfigurationDS{
+		Bucket: BlobStoreGoogleCloudBucket{
+			Name:      types.StringValue(api.BucketConfiguration.Bucket.Name),
+			Prefix:    types.StringPointerValue(api.BucketConfiguration.Bucket.Prefix),
+			Region:    types.StringPointerValue(api.BucketConfiguration.Bucket.Region),
+			ProjectId: types.StringPointerValue(api.BucketConfiguration.Bucket.ProjectId),
+		},
+	}
+	if api.BucketConfiguration.BucketSecurity != nil {
+		m.BucketConfiguration.Authentication = &BlobStoreGoogleCloudAuthenticationDS{
+			AuthenticationMethod: types.StringValue(api.BucketConfiguration.BucketSecurity.AuthenticationMethod),
+		}
+	}
+	if api.BucketConfiguration.Encryption != nil {
+		m.BucketConfiguration.Encryption = &BlobStoreGoogleCloudEncryption{
+			EncryptionType: types.StringPointerValue(api.BucketConfiguration.Encryption.EncryptionType),
+			EncryptionKey:  types.StringPointerValue(api.BucketConfiguration.Encryption.EncryptionKey),
+		}
+	}
+}
+
+// BlobStoreGoogleCloudBucketConfigurationDS
+// ------------------------------------
+type BlobStoreGoogleCloudBucketConfigurationDS struct {
+	Bucket         BlobStoreGoogleCloudBucket            `tfsdk:"bucket"`
+	Authentication *BlobStoreGoogleCloudAuthenticationDS `tfsdk:"authentication"`
+	Encryption     *BlobStoreGoogleCloudEncryption       `tfsdk:"encryption"`
+}
+
+// BlobStoreGoogleCloudAuthenticationDS - the account key is never returned by Sonatype Nexus Repository
+// ------------------------------------
+type BlobStoreGoogleCloudAuthenticationDS struct {
+	AuthenticationMethod types.String `tfsdk:"authentication_method"`
+}
+
 // BlobStoreGoogleCloudBucketConfiguration
 // ------------------------------------
 type BlobStoreGoogleCloudBucketConfiguration struct {
@@ -381,8 +517,8 @@ type BlobStoreGoogleCloudEncryption struct {
 // BlobStoreAcsModelDS
 // ------------------------------------
 type BlobStoreAcsModelDS struct {
+	BlobStoreCommonModelDS
 	Name                types.String                          `tfsdk:"name"`
-	SoftQuota           *BlobStoreSoftQuota                   `tfsdk:"soft_quota"`
 	BucketConfiguration *blobStoreAcsBucketConfigurationModel `tfsdk:"bucket_configuration"`
 }
 
@@ -17,7 +17,11 @@
 package model
 
 import (
+	"terraform-provider-sonatyperepo/internal/provider/common"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
 )
 
 // CleanupPolicyModel represents the Terraform model for a cleanup policy
@@ -27,6 +31,8 @@ type CleanupPolicyModel struct {
 	Format      types.String                `tfsdk:"format"`
 	Criteria    *CleanupPolicyCriteriaModel `tfsdk:"criteria"`
 	Retain      types.Int64                 `tfsdk:"retain"`
+	SortBy      types.String                `tfsdk:"sort_by"`
+	ForceDetach types.Bool                  `tfsdk:"force_detach"`
 	LastUpdated types.String                `tfsdk:"last_updated"`
 }
 
@@ -37,3 +43,66 @@ type CleanupPolicyCriteriaModel struct {
 	ReleaseType     types.String `tfsdk:"release_type"`
 	AssetRegex      types.String `tfsdk:"asset_regex"`
 }
+
+// CleanupPolicyModelDS represents a cleanup policy for data sources
+type CleanupPolicyModelDS struct {
+	Name     types.String                `tfsdk:"name"`
+	Notes    types.String                `tfsdk:"notes"`
+	Format   types.String                `tfsdk:"format"`
+	Criteria *CleanupPolicyCriteriaModel `tfsdk:"criteria"`
+	Retain   types.Int64                 `tfsdk:"retain"`
+	SortBy   types.String                `tfsdk:"sort_by"`
+}
+
+// CleanupPoliciesModel represents a list of cleanup policies for data source
+type CleanupPoliciesModel struct {
+	Format          types.String           `tfsdk:"format"`
+	CleanupPolicies []CleanupPolicyModelDS `tfsdk:"cleanup_policies"`
+}
+
+// CleanupPolicyPreviewModel represents the cleanup policy preview data source
+type CleanupPolicyPreviewModel struct {
+	RepositoryName types.String                         `tfsdk:"repository_name"`
+	Criteria       *CleanupPolicyCriteriaModel          `tfsdk:"criteria"`
+	Retain         types.Int64                          `tfsdk:"retain"`
+	ComponentCount types.Int64                          `tfsdk:"component_count"`
+	Components     []CleanupPolicyPreviewComponentModel `tfsdk:"components"`
+}
+
+// CleanupPolicyPreviewComponentModel is a Component that a cleanup policy would delete
+type CleanupPolicyPreviewComponentModel struct {
+	Group   types.String `tfsdk:"group"`
+	Name    types.String `tfsdk:"name"`
+	Version types.String `tfsdk:"version"`
+}
+
+// MapToPreview maps the configured Repository and criteria to a preview - unset criteria are not applied.
+func (m *CleanupPolicyPreviewModel) MapToPreview() common.CleanupPolicyPreview {
+	preview := common.CleanupPolicyPreview{
+		RepositoryName: m.RepositoryName.ValueString(),
+	}
+	if m.Criteria != nil {
+		preview.LastBlobUpdated = m.Criteria.LastBlobUpdated.ValueInt64Pointer()
+		preview.LastDownloaded = m.Criteria.LastDownloaded.ValueInt64Pointer()
+		preview.ReleaseType = m.Criteria.ReleaseType.ValueStringPointer()
+		preview.AssetRegex = m.Criteria.AssetRegex.ValueStringPointer()
+	}
+	if !m.Retain.IsNull() {
+		retain := int32(m.Retain.ValueInt64())
+		preview.Retain = &retain
+	}
+	return preview
+}
+
+// MapFromApi maps the Components a cleanup policy would delete.
+func (m *CleanupPolicyPreviewModel) MapFromApi(api []sonatyperepo.ComponentXO) {
+	m.ComponentCount = types.Int64Value(int64(len(api)))
+	m.Components = make([]CleanupPolicyPreviewComponentModel, 0, len(api))
+	for _, c := range api {
+		m.Components = append(m.Components, CleanupPolicyPreviewComponentModel{
+			Group:   types.StringPointerValue(c.Group),
+			Name:    types.StringPointerValue(c.Name),
+			Version: types.StringPointerValue(c.Version),
+		})
+	}
+}
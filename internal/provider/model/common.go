@@ -22,6 +22,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -160,3 +161,56 @@ func fieldValueToString(v reflect.Value) string {
 		return fmt.Sprintf("%v", v.Interface())
 	}
 }
+
+// MapToStruct is the reverse of StructToMap - it sets the Terraform typed fields of a struct from a
+// map[string]string keyed by the "nxrm" tag.
+//
+// Only keys present in the map are applied. A field that is null is left null when the map holds an
+// empty value for it, and values that cannot be parsed into the field's type are ignored.
+func MapToStruct(values map[string]string, v any) {
+	val := reflect.ValueOf(v)
+	if val.Kind() != reflect.Pointer || val.Elem().Kind() != reflect.Struct {
+		return
+	}
+	val = val.Elem()
+
+	typ := val.Type()
+	for i := 0; i < val.NumField(); i++ {
+		field := typ.Field(i)
+		fieldVal := val.Field(i)
+
+		key := field.Tag.Get("nxrm")
+		if key == "" {
+			key = field.Name
+		}
+		if key == "-" || !fieldVal.CanSet() {
+			continue
+		}
+
+		value, ok := values[key]
+		if !ok {
+			continue
+		}
+
+		if current, ok := fieldVal.Interface().(attr.Value); ok && current.IsNull() && value == "" {
+			continue
+		}
+
+		switch fieldVal.Interface().(type) {
+		case types.String:
+			fieldVal.Set(reflect.ValueOf(types.StringValue(value)))
+		case types.Bool:
+			if b, err := strconv.ParseBool(value); err == nil {
+				fieldVal.Set(reflect.ValueOf(types.BoolValue(b)))
+			}
+		case types.Int32:
+			if n, err := strconv.ParseInt(value, 10, 32); err == nil {
+				fieldVal.Set(reflect.ValueOf(types.Int32Value(int32(n))))
+			}
+		case types.Int64:
+			if n, err := strconv.ParseInt(value, 10, 64); err == nil {
+				fieldVal.Set(reflect.ValueOf(types.Int64Value(n)))
+			}
+		}
+	}
+}
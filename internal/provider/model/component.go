@@ -0,0 +1,290 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+)
+
+const componentChecksumSha256 = "sha256"
+
+// ComponentModelResource
+// --------------------------------------------------------
+type ComponentModelResource struct {
+	Repository  types.String          `tfsdk:"repository"`
+	Format      types.String          `tfsdk:"format"`
+	File        types.String          `tfsdk:"file"`
+	FileSha256  types.String          `tfsdk:"file_sha256"`
+	Raw         *ComponentRawModel    `tfsdk:"raw"`
+	Maven2      *ComponentMaven2Model `tfsdk:"maven2"`
+	Yum         *ComponentYumModel    `tfsdk:"yum"`
+	R           *ComponentRModel      `tfsdk:"r"`
+	ComponentId types.String          `tfsdk:"component_id"`
+	Group       types.String          `tfsdk:"group"`
+	Name        types.String          `tfsdk:"name"`
+	Version     types.String          `tfsdk:"version"`
+	AssetPath   types.String          `tfsdk:"asset_path"`
+	DownloadUrl types.String          `tfsdk:"download_url"`
+	LastUpdated types.String          `tfsdk:"last_updated"`
+}
+
+type ComponentRawModel struct {
+	Directory types.String `tfsdk:"directory"`
+	Filename  types.String `tfsdk:"filename"`
+}
+
+type ComponentMaven2Model struct {
+	GroupId     types.String `tfsdk:"group_id"`
+	ArtifactId  types.String `tfsdk:"artifact_id"`
+	Version     types.String `tfsdk:"version"`
+	Packaging   types.String `tfsdk:"packaging"`
+	Extension   types.String `tfsdk:"extension"`
+	Classifier  types.String `tfsdk:"classifier"`
+	GeneratePom types.Bool   `tfsdk:"generate_pom"`
+}
+
+type ComponentYumModel struct {
+	Directory types.String `tfsdk:"directory"`
+	Filename  types.String `tfsdk:"filename"`
+}
+
+type ComponentRModel struct {
+	PathId types.String `tfsdk:"path_id"`
+}
+
+// MapToUpload maps the model to a Component upload of the given asset.
+func (m *ComponentModelResource) MapToUpload(asset *os.File) common.ComponentUpload {
+	upload := common.ComponentUpload{
+		Format: m.Format.ValueString(),
+		Asset:  asset,
+	}
+	if m.Raw != nil {
+		upload.Directory = m.Raw.Directory.ValueString()
+		upload.Filename = m.Raw.Filename.ValueString()
+	}
+	if m.Maven2 != nil {
+		upload.GroupId = m.Maven2.GroupId.ValueString()
+		upload.ArtifactId = m.Maven2.ArtifactId.ValueString()
+		upload.Version = m.Maven2.Version.ValueString()
+		upload.Packaging = m.Maven2.Packaging.ValueString()
+		upload.Extension = m.Maven2.Extension.ValueString()
+		upload.Classifier = m.Maven2.Classifier.ValueString()
+		upload.GeneratePom = m.Maven2.GeneratePom.ValueBool()
+	}
+	if m.Yum != nil {
+		upload.Directory = m.Yum.Directory.ValueString()
+		upload.Filename = m.Yum.Filename.ValueString()
+	}
+	if m.R != nil {
+		upload.PathId = m.R.PathId.ValueString()
+	}
+	return upload
+}
+
+// MapFromApi maps the Component and the asset uploaded from File to the model. If no asset of the
+// Component matches the checksum of File, FileSha256 takes the checksum of the Component's first asset
+// so that the drift is planned as a replacement.
+func (m *ComponentModelResource) MapFromApi(api *sonatyperepo.ComponentXO) {
+	m.ComponentId = types.StringPointerValue(api.Id)
+	m.Group = types.StringPointerValue(api.Group)
+	m.Name = types.StringPointerValue(api.Name)
+	m.Version = types.StringPointerValue(api.Version)
+
+	var asset *sonatyperepo.AssetXO
+	for i, a := range api.Assets {
+		if AssetChecksum(a, componentChecksumSha256) == m.FileSha256.ValueString() {
+			asset = &api.Assets[i]
+			break
+		}
+	}
+	if asset == nil && len(api.Assets) > 0 {
+		asset = &api.Assets[0]
+		m.FileSha256 = types.StringValue(AssetChecksum(*asset, componentChecksumSha256))
+	}
+	if asset != nil {
+		m.AssetPath = types.StringPointerValue(asset.Path)
+		m.DownloadUrl = types.StringPointerValue(asset.DownloadUrl)
+	}
+}
+
+// IsUploadedComponent is true if the Component has an asset matching the checksum of File, at the
+// coordinates given for the upload - the same content may also have been uploaded elsewhere in the Repository.
+func (m *ComponentModelResource) IsUploadedComponent(api sonatyperepo.ComponentXO) bool {
+	if m.Maven2 != nil && (api.GetGroup() != m.Maven2.GroupId.ValueString() ||
+		api.GetName() != m.Maven2.ArtifactId.ValueString() ||
+		api.GetVersion() != m.Maven2.Version.ValueString()) {
+		return false
+	}
+	for _, a := range api.Assets {
+		if AssetChecksum(a, componentChecksumSha256) == m.FileSha256.ValueString() && m.isUploadedAssetPath(strings.TrimPrefix(a.GetPath(), "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// isUploadedAssetPath is true if the asset path matches the directory and filename given for the upload.
+// Other formats take the path from the asset's own metadata, so any path matches.
+func (m *ComponentModelResource) isUploadedAssetPath(assetPath string) bool {
+	switch {
+	case m.Raw != nil:
+		return assetPath == uploadedAssetPath(m.Raw.Directory, m.Raw.Filename)
+	case m.Yum != nil:
+		return assetPath == uploadedAssetPath(m.Yum.Directory, m.Yum.Filename)
+	case m.R != nil:
+		return path.Dir(assetPath) == strings.Trim(m.R.PathId.ValueString(), "/")
+	}
+	return true
+}
+
+func uploadedAssetPath(directory, filename types.String) string {
+	return strings.TrimPrefix(path.Join(directory.ValueString(), filename.ValueString()), "/")
+}
+
+// AssetChecksum returns the checksum of an asset for the given algorithm, or an empty string if
+// Sonatype Nexus Repository did not report one.
+func AssetChecksum(api sonatyperepo.AssetXO, algorithm string) string {
+	checksum, ok := api.GetChecksum()[algorithm]
+	if !ok || checksum == nil {
+		return ""
+	}
+	return fmt.Sprintf("%v", checksum)
+}
+
+// ComponentSearchFilterModel are the Search API criteria shared by the Component and Asset data sources
+// --------------------------------------------------------
+type ComponentSearchFilterModel struct {
+	Repository types.String `tfsdk:"repository"`
+	Format     types.String `tfsdk:"format"`
+	Group      types.String `tfsdk:"group"`
+	Name       types.String `tfsdk:"name"`
+	Version    types.String `tfsdk:"version"`
+	Prerelease types.Bool   `tfsdk:"prerelease"`
+	Sha1       types.String `tfsdk:"sha1"`
+	Sha256     types.String `tfsdk:"sha256"`
+	Sha512     types.String `tfsdk:"sha512"`
+	Md5        types.String `tfsdk:"md5"`
+	Sort       types.String `tfsdk:"sort"`
+	Direction  types.String `tfsdk:"direction"`
+}
+
+// MapToSearch maps the configured criteria to a search - unset criteria are not applied.
+func (m *ComponentSearchFilterModel) MapToSearch() common.ComponentSearch {
+	return common.ComponentSearch{
+		Repository: m.Repository.ValueStringPointer(),
+		Format:     m.Format.ValueStringPointer(),
+		Group:      m.Group.ValueStringPointer(),
+		Name:       m.Name.ValueStringPointer(),
+		Version:    m.Version.ValueStringPointer(),
+		Prerelease: m.Prerelease.ValueBoolPointer(),
+		Sha1:       m.Sha1.ValueStringPointer(),
+		Sha256:     m.Sha256.ValueStringPointer(),
+		Sha512:     m.Sha512.ValueStringPointer(),
+		Md5:        m.Md5.ValueStringPointer(),
+		Sort:       m.Sort.ValueStringPointer(),
+		Direction:  m.Direction.ValueStringPointer(),
+	}
+}
+
+// ComponentsModel
+// --------------------------------------------------------
+type ComponentsModel struct {
+	ComponentSearchFilterModel
+	Components []ComponentModel `tfsdk:"components"`
+}
+
+type ComponentModel struct {
+	Id         types.String `tfsdk:"id"`
+	Repository types.String `tfsdk:"repository"`
+	Format     types.String `tfsdk:"format"`
+	Group      types.String `tfsdk:"group"`
+	Name       types.String `tfsdk:"name"`
+	Version    types.String `tfsdk:"version"`
+	Assets     []AssetModel `tfsdk:"assets"`
+}
+
+func (m *ComponentModel) MapFromApi(api *sonatyperepo.ComponentXO) {
+	m.Id = types.StringPointerValue(api.Id)
+	m.Repository = types.StringPointerValue(api.Repository)
+	m.Format = types.StringPointerValue(api.Format)
+	m.Group = types.StringPointerValue(api.Group)
+	m.Name = types.StringPointerValue(api.Name)
+	m.Version = types.StringPointerValue(api.Version)
+	m.Assets = make([]AssetModel, 0, len(api.Assets))
+	for _, a := range api.Assets {
+		asset := AssetModel{}
+		asset.MapFromApi(&a)
+		m.Assets = append(m.Assets, asset)
+	}
+}
+
+// AssetsModel
+// --------------------------------------------------------
+type AssetsModel struct {
+	ComponentSearchFilterModel
+	Assets []AssetModel `tfsdk:"assets"`
+}
+
+type AssetModel struct {
+	Id           types.String `tfsdk:"id"`
+	Repository   types.String `tfsdk:"repository"`
+	Format       types.String `tfsdk:"format"`
+	Path         types.String `tfsdk:"path"`
+	DownloadUrl  types.String `tfsdk:"download_url"`
+	ContentType  types.String `tfsdk:"content_type"`
+	FileSize     types.Int64  `tfsdk:"file_size"`
+	LastModified types.String `tfsdk:"last_modified"`
+	Sha1         types.String `tfsdk:"sha1"`
+	Sha256       types.String `tfsdk:"sha256"`
+	Sha512       types.String `tfsdk:"sha512"`
+	Md5          types.String `tfsdk:"md5"`
+}
+
+func (m *AssetModel) MapFromApi(api *sonatyperepo.AssetXO) {
+	m.Id = types.StringPointerValue(api.Id)
+	m.Repository = types.StringPointerValue(api.Repository)
+	m.Format = types.StringPointerValue(api.Format)
+	m.Path = types.StringPointerValue(api.Path)
+	m.DownloadUrl = types.StringPointerValue(api.DownloadUrl)
+	m.ContentType = types.StringPointerValue(api.ContentType)
+	m.FileSize = types.Int64PointerValue(api.FileSize)
+	m.LastModified = types.StringNull()
+	if api.LastModified != nil {
+		m.LastModified = types.StringValue(api.LastModified.Format(time.RFC850))
+	}
+	m.Sha1 = assetChecksumValue(*api, "sha1")
+	m.Sha256 = assetChecksumValue(*api, componentChecksumSha256)
+	m.Sha512 = assetChecksumValue(*api, "sha512")
+	m.Md5 = assetChecksumValue(*api, "md5")
+}
+
+func assetChecksumValue(api sonatyperepo.AssetXO, algorithm string) types.String {
+	if checksum := AssetChecksum(api, algorithm); checksum != "" {
+		return types.StringValue(checksum)
+	}
+	return types.StringNull()
+}
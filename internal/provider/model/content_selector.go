@@ -17,6 +17,8 @@
 package model
 
 import (
+	"terraform-provider-sonatyperepo/internal/provider/common"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
@@ -59,3 +61,51 @@ func (m *ContentSelectorModelResource) MapToApiUpdate(api *sonatyperepo.ContentS
 	api.Description = m.Description.ValueStringPointer()
 	api.Expression = m.Expression.ValueStringPointer()
 }
+
+// ContentSelectorPreviewModel represents the content selector preview data source
+type ContentSelectorPreviewModel struct {
+	Expression      types.String                       `tfsdk:"expression"`
+	ContentSelector types.String                       `tfsdk:"content_selector"`
+	Repository      types.String                       `tfsdk:"repository"`
+	Format          types.String                       `tfsdk:"format"`
+	AssetCount      types.Int64                        `tfsdk:"asset_count"`
+	Paths           []types.String                     `tfsdk:"paths"`
+	Assets          []ContentSelectorPreviewAssetModel `tfsdk:"assets"`
+}
+
+// ContentSelectorPreviewAssetModel is an Asset matched by a Content Selector expression
+type ContentSelectorPreviewAssetModel struct {
+	Repository types.String `tfsdk:"repository"`
+	Format     types.String `tfsdk:"format"`
+	Path       types.String `tfsdk:"path"`
+}
+
+// MapToPreview maps the expression and Repository filter to a preview - all Repositories (optionally of a
+// single format) are previewed when no Repository is given.
+func (m *ContentSelectorPreviewModel) MapToPreview() common.ContentSelectorPreview {
+	repository := common.CONTENT_SELECTOR_PREVIEW_ALL_REPOSITORIES
+	if !m.Repository.IsNull() {
+		repository = m.Repository.ValueString()
+	} else if !m.Format.IsNull() {
+		repository += m.Format.ValueString()
+	}
+	return common.ContentSelectorPreview{
+		Expression: m.Expression.ValueString(),
+		Repository: repository,
+	}
+}
+
+// MapFromApi maps the Assets matched by the expression.
+func (m *ContentSelectorPreviewModel) MapFromApi(api common.ContentSelectorPreviewResult) {
+	m.AssetCount = types.Int64Value(api.Total)
+	m.Paths = make([]types.String, 0, len(api.Results))
+	m.Assets = make([]ContentSelectorPreviewAssetModel, 0, len(api.Results))
+	for _, a := range api.Results {
+		m.Paths = append(m.Paths, types.StringValue(a.Name))
+		m.Assets = append(m.Assets, ContentSelectorPreviewAssetModel{
+			Repository: types.StringValue(a.Repository),
+			Format:     types.StringValue(a.Format),
+			Path:       types.StringValue(a.Name),
+		})
+	}
+}
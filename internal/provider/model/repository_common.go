@@ -17,6 +17,8 @@
 package model
 
 import (
+	"slices"
+
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
@@ -41,6 +43,23 @@ type BasicRepositoryModel struct {
 	LastUpdated types.String            `tfsdk:"last_updated"`
 }
 
+// DetachCleanupPolicy removes the named Cleanup Policy, leaving any others applied
+func (m *BasicRepositoryModel) DetachCleanupPolicy(cleanupPolicyName string) {
+	if m.Cleanup == nil {
+		return
+	}
+	remaining := slices.DeleteFunc(slices.Clone(m.Cleanup.PolicyNames), func(p types.String) bool {
+		return p.ValueString() == cleanupPolicyName
+	})
+	if len(remaining) == 0 {
+		m.Cleanup = nil
+		return
+	}
+	cleanup := *m.Cleanup
+	cleanup.PolicyNames = remaining
+	m.Cleanup = &cleanup
+}
+
 type RepositoryCleanupModel struct {
 	PolicyNames []types.String `tfsdk:"policy_names"`
 }
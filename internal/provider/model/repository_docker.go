@@ -295,3 +295,17 @@ func (m *RepositoryDockerGroupModel) ToApiCreateModel() sonatyperepo.DockerGroup
 func (m *RepositoryDockerGroupModel) ToApiUpdateModel() sonatyperepo.DockerGroupRepositoryApiRequest {
 	return m.ToApiCreateModel()
 }
+
+// Docker Connectors
+// ----------------------------------------
+type DockerConnectorsModel struct {
+	Connectors []DockerConnectorModel `tfsdk:"connectors"`
+}
+
+type DockerConnectorModel struct {
+	RepositoryName types.String `tfsdk:"repository_name"`
+	RepositoryType types.String `tfsdk:"repository_type"`
+	HttpPort       types.Int32  `tfsdk:"http_port"`
+	HttpsPort      types.Int32  `tfsdk:"https_port"`
+	Subdomain      types.String `tfsdk:"subdomain"`
+}
@@ -80,3 +80,12 @@ func (m *repositoryGroupDeployDetails) MapToApi(api *sonatyperepo.GroupDeployAtt
 	}
 	api.WritableMember = m.WritableMember.ValueStringPointer()
 }
+
+// RepositoryGroupMemberModel
+// ------------------------------------
+type RepositoryGroupMemberModel struct {
+	GroupName   types.String `tfsdk:"group_name"`
+	MemberName  types.String `tfsdk:"member_name"`
+	Position    types.Int64  `tfsdk:"position"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
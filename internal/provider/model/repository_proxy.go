@@ -37,6 +37,17 @@ type RepositoryProxyModel struct {
 	Replication   *RepositoryReplicationModel  `tfsdk:"replication"`
 }
 
+// DetachRoutingRule removes the Routing Rule applied to this Repository
+func (m *RepositoryProxyModel) DetachRoutingRule() {
+	m.RoutingRule = types.StringNull()
+}
+
+// HasHttpAuthentication is true if this Repository authenticates to its remote - Sonatype Nexus Repository
+// does not return the credentials, so it cannot be updated from what it returns
+func (m *RepositoryProxyModel) HasHttpAuthentication() bool {
+	return m.HttpClient.Authentication != nil
+}
+
 // repositoryProxyModel
 // --------------------------------------------------------
 type repositoryProxyModel struct {
@@ -56,6 +56,7 @@ type RoutingRuleModel struct {
 	Description types.String `tfsdk:"description"`
 	Mode        types.String `tfsdk:"mode"`
 	Matchers    types.Set    `tfsdk:"matchers"`
+	ForceDetach types.Bool   `tfsdk:"force_detach"`
 	LastUpdated types.String `tfsdk:"last_updated"`
 }
 
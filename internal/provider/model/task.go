@@ -17,22 +17,27 @@
 package model
 
 import (
+	"time"
+
 	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/quartz"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Task Frequency
 // ----------------------------------------
-type taskFrequency struct {
+type TaskFrequency struct {
 	Schedule       types.String  `tfsdk:"schedule"`
 	StartDate      types.Int64   `tfsdk:"start_date"`
 	TimezoneOffset types.String  `tfsdk:"timezone_offset"`
+	Timezone       types.String  `tfsdk:"timezone"`
 	RecurringDays  []types.Int32 `tfsdk:"recurring_days"`
 	CronExpression types.String  `tfsdk:"cron_expression"`
 }
 
-func (f *taskFrequency) ToApiModel(api *common.TaskFrequencyApiModel) {
+func (f *TaskFrequency) ToApiModel(api *common.TaskFrequencyApiModel) {
 	api.CronExpression = f.CronExpression.ValueStringPointer()
 	api.RecurringDays = make([]int32, 0)
 	for _, rd := range f.RecurringDays {
@@ -43,10 +48,211 @@ func (f *taskFrequency) ToApiModel(api *common.TaskFrequencyApiModel) {
 	api.TimeZoneOffset = f.TimezoneOffset.ValueStringPointer()
 }
 
+// PlannedTimezoneOffset returns the timezone_offset to send to Sonatype Nexus Repository. When a timezone
+// is given, this is its offset from UTC at the next time the Task will run (or its start_date, if it
+// will not run again) - so that it changes as daylight saving time starts and ends. Otherwise it is the
+// timezone_offset as given.
+func (f *TaskFrequency) PlannedTimezoneOffset(now time.Time) types.String {
+	if f.Timezone.IsNull() {
+		if f.TimezoneOffset.IsUnknown() {
+			return types.StringNull()
+		}
+		return f.TimezoneOffset
+	}
+
+	at := now
+	if next := f.NextFireTimes(now, 1); len(next) > 0 {
+		at = next[0]
+	} else if !f.StartDate.IsNull() {
+		at = time.UnixMilli(f.StartDate.ValueInt64())
+	}
+	return types.StringValue(at.In(f.Location()).Format("-07:00"))
+}
+
+// MapFromApi refreshes the Frequency from a Task as returned by the Tasks API.
+//
+// Sonatype Nexus Repository fills in a start date and time zone offset when none are given, so these
+// are only refreshed once they are set in state (or when the Frequency is being imported).
+func (f *TaskFrequency) MapFromApi(api *common.TaskApiModel) {
+	importing := f.Schedule.IsNull()
+	f.Schedule = types.StringPointerValue(api.Schedule)
+
+	if api.StartDate != nil && (importing || !f.StartDate.IsNull()) {
+		f.StartDate = types.Int64PointerValue(api.StartDate)
+	}
+	if api.TimeZoneOffset != nil && (importing || !f.TimezoneOffset.IsNull()) {
+		f.TimezoneOffset = types.StringPointerValue(api.TimeZoneOffset)
+	}
+	if api.RecurringDays != nil && !recurringDaysEqual(f.RecurringDays, api.RecurringDays) {
+		f.RecurringDays = make([]types.Int32, 0, len(api.RecurringDays))
+		for _, rd := range api.RecurringDays {
+			f.RecurringDays = append(f.RecurringDays, types.Int32Value(rd))
+		}
+	}
+	if api.CronExpression != nil && !(f.CronExpression.IsNull() && *api.CronExpression == "") {
+		f.CronExpression = types.StringPointerValue(api.CronExpression)
+	}
+}
+
+// NextFireTimes returns up to count times, after the given time, that a Task with this Frequency will run.
+//
+// Nothing is returned for a "manual" schedule, or when the times depend on a start_date that is not set.
+func (f *TaskFrequency) NextFireTimes(after time.Time, count int) []time.Time {
+	loc := f.Location()
+	after = after.In(loc)
+	var start time.Time
+	if !f.StartDate.IsNull() {
+		start = time.UnixMilli(f.StartDate.ValueInt64()).In(loc)
+	}
+
+	times := make([]time.Time, 0, count)
+	switch f.Schedule.ValueString() {
+	case common.FREQUENCY_SCHEDULE_CRON:
+		expression, err := quartz.Parse(f.CronExpression.ValueString())
+		if err != nil {
+			return times
+		}
+		next := after
+		if start.After(after) {
+			next = start.Add(-time.Second)
+		}
+		for len(times) < count {
+			if next = expression.Next(next); next.IsZero() {
+				break
+			}
+			times = append(times, next)
+		}
+
+	case common.FREQUENCY_SCHEDULE_ONCE:
+		if !start.IsZero() && start.After(after) {
+			times = append(times, start)
+		}
+
+	case common.FREQUENCY_SCHEDULE_HOURLY, common.FREQUENCY_SCHEDULE_DAILY:
+		if start.IsZero() {
+			return times
+		}
+		if f.Schedule.ValueString() == common.FREQUENCY_SCHEDULE_HOURLY {
+			next := start
+			if !next.After(after) {
+				next = next.Add((after.Sub(start)/time.Hour + 1) * time.Hour)
+			}
+			for len(times) < count {
+				times = append(times, next)
+				next = next.Add(time.Hour)
+			}
+			return times
+		}
+		// Daily runs keep the time of day of start_date, even as daylight saving time starts and ends
+		days := 0
+		if !start.After(after) {
+			days = max(int(after.Sub(start)/(24*time.Hour))-1, 0)
+		}
+		for next := start.AddDate(0, 0, days); len(times) < count; next = start.AddDate(0, 0, days) {
+			if next.After(after) {
+				times = append(times, next)
+			}
+			days++
+		}
+
+	case common.FREQUENCY_SCHEDULE_WEEKLY, common.FREQUENCY_SCHEDULE_MONTHLY:
+		if start.IsZero() || len(f.RecurringDays) == 0 {
+			return times
+		}
+		days := make(map[int]bool, len(f.RecurringDays))
+		for _, rd := range f.RecurringDays {
+			days[int(rd.ValueInt32())] = true
+		}
+		day := start
+		if after.After(day) {
+			day = after
+		}
+		day = time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), start.Second(), 0, loc)
+		// Every day of the week or month recurs within a few years
+		for limit := day.AddDate(5, 0, 0); len(times) < count && day.Before(limit); day = day.AddDate(0, 0, 1) {
+			if day.Before(start) || !day.After(after) {
+				continue
+			}
+			if f.Schedule.ValueString() == common.FREQUENCY_SCHEDULE_WEEKLY {
+				// Days of the week are numbered from 1 (Monday) to 7 (Sunday)
+				dayOfWeek := int(day.Weekday())
+				if dayOfWeek == 0 {
+					dayOfWeek = 7
+				}
+				if days[dayOfWeek] {
+					times = append(times, day)
+				}
+			} else if days[day.Day()] {
+				times = append(times, day)
+			}
+		}
+	}
+	return times
+}
+
+// NextFireTimesValue returns the NextFireTimes formatted as RFC 3339 in the time zone of this Frequency.
+func (f *TaskFrequency) NextFireTimesValue(after time.Time, count int) types.List {
+	values := make([]attr.Value, 0, count)
+	for _, t := range f.NextFireTimes(after, count) {
+		values = append(values, types.StringValue(t.Format(time.RFC3339)))
+	}
+	return types.ListValueMust(types.StringType, values)
+}
+
+// Location returns the time zone this Frequency is scheduled in - the timezone when set, otherwise the
+// fixed timezone_offset when set, otherwise UTC.
+func (f *TaskFrequency) Location() *time.Location {
+	if !f.Timezone.IsNull() && !f.Timezone.IsUnknown() {
+		if loc, err := time.LoadLocation(f.Timezone.ValueString()); err == nil {
+			return loc
+		}
+	}
+	if f.TimezoneOffset.IsNull() || f.TimezoneOffset.IsUnknown() {
+		return time.UTC
+	}
+	offset, err := time.Parse("-07:00", f.TimezoneOffset.ValueString())
+	if err != nil {
+		return time.UTC
+	}
+	return offset.Location()
+}
+
+// recurringDaysEqual compares recurring days ignoring order, so that the order given in configuration
+// is kept when Sonatype Nexus Repository returns the same days sorted.
+func recurringDaysEqual(state []types.Int32, api []int32) bool {
+	if len(state) != len(api) {
+		return false
+	}
+	days := make(map[int32]int, len(api))
+	for _, rd := range api {
+		days[rd]++
+	}
+	for _, rd := range state {
+		if days[rd.ValueInt32()] == 0 {
+			return false
+		}
+		days[rd.ValueInt32()]--
+	}
+	return true
+}
+
 // Tasks Model
 // ----------------------------------------
 type TasksModel struct {
-	Tasks []TaskModelSimple `tfsdk:"tasks"`
+	Type         types.String      `tfsdk:"type"`
+	CurrentState types.String      `tfsdk:"current_state"`
+	Tasks        []TaskModelSimple `tfsdk:"tasks"`
+}
+
+// Matches is true when the Task matches the type and current state filters that are set.
+func (m *TasksModel) Matches(api *common.TaskApiModel) bool {
+	if !m.Type.IsNull() && (api.Type == nil || *api.Type != m.Type.ValueString()) {
+		return false
+	}
+	if !m.CurrentState.IsNull() && (api.CurrentState == nil || *api.CurrentState != m.CurrentState.ValueString()) {
+		return false
+	}
+	return true
 }
 
 // Task Model Base
@@ -60,13 +266,31 @@ type baseTaskModel struct {
 // ----------------------------------------
 type TaskModelSimple struct {
 	baseTaskModel
-	Type types.String `tfsdk:"type"`
+	Type          types.String `tfsdk:"type"`
+	CurrentState  types.String `tfsdk:"current_state"`
+	LastRun       types.String `tfsdk:"last_run"`
+	LastRunResult types.String `tfsdk:"last_run_result"`
+	NextRun       types.String `tfsdk:"next_run"`
+	Message       types.String `tfsdk:"message"`
 }
 
 func (m *TaskModelSimple) MapFromApi(api *common.TaskApiModel) {
 	m.Id = types.StringPointerValue(api.Id)
 	m.Name = types.StringPointerValue(api.Name)
 	m.Type = types.StringPointerValue(api.Type)
+	m.CurrentState = types.StringPointerValue(api.CurrentState)
+	m.LastRun = timeValue(api.LastRun)
+	m.LastRunResult = types.StringPointerValue(api.LastRunResult)
+	m.NextRun = timeValue(api.NextRun)
+	m.Message = types.StringPointerValue(api.Message)
+}
+
+// timeValue formats a time returned by the Tasks API as RFC 3339, or null if there is none.
+func timeValue(t *time.Time) types.String {
+	if t == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(t.Format(time.RFC3339))
 }
 
 // Base Task Model (Complete) - used for create and update
@@ -76,7 +300,8 @@ type BaseTaskModel struct {
 	Enabled               types.Bool     `tfsdk:"enabled"`
 	AlertEmail            types.String   `tfsdk:"alert_email"`
 	NotificationCondition types.String   `tfsdk:"notification_condition"`
-	Frequency             *taskFrequency `tfsdk:"frequency"`
+	Frequency             *TaskFrequency `tfsdk:"frequency"`
+	NextFireTimes         types.List     `tfsdk:"next_fire_times"`
 	LastUpdated           types.String   `tfsdk:"last_updated"`
 }
 
@@ -85,6 +310,34 @@ func (m *BaseTaskModel) MapFromApi(api *common.TaskApiModel) {
 	if api.Name != nil {
 		m.Name = types.StringPointerValue(api.Name)
 	}
+	if api.Enabled != nil {
+		m.Enabled = types.BoolPointerValue(api.Enabled)
+	}
+	if api.AlertEmail != nil && !(m.AlertEmail.IsNull() && *api.AlertEmail == "") {
+		m.AlertEmail = types.StringPointerValue(api.AlertEmail)
+	}
+	if api.NotificationCondition != nil {
+		m.NotificationCondition = types.StringPointerValue(api.NotificationCondition)
+	}
+	if api.Schedule != nil {
+		if m.Frequency == nil {
+			m.Frequency = &TaskFrequency{}
+		}
+		m.Frequency.MapFromApi(api)
+	}
+}
+
+// mapTaskPropertiesFromApi refreshes the Properties of a Task from those returned by the Tasks API,
+// creating them if they are not yet in state (i.e. on import).
+func mapTaskPropertiesFromApi[T any](properties *T, api *common.TaskApiModel) *T {
+	if api.Properties == nil {
+		return properties
+	}
+	if properties == nil {
+		properties = new(T)
+	}
+	MapToStruct(*api.Properties, properties)
+	return properties
 }
 
 func (m *BaseTaskModel) toApiCreateModel() *common.TaskCreateApiModel {
@@ -45,6 +45,11 @@ type TaskBlobstoreCompactModel struct {
 	Properties *TaskPropertiesBlobstoreCompact `tfsdk:"properties"`
 }
 
+func (m *TaskBlobstoreCompactModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
 func (m *TaskBlobstoreCompactModel) ToApiCreateModel(version common.SystemVersion) *common.TaskCreateApiModel {
 	api := m.toApiCreateModel()
 	api.Type = common.TASK_TYPE_BLOBSTORE_COMPACT.String()
@@ -61,3 +66,146 @@ func (m *TaskBlobstoreCompactModel) ToApiUpdateModel(version common.SystemVersio
 	}
 	return api
 }
+
+// Properties for Tasks that only take a Blob Store
+//
+// Applies to blobstore.delete-temp-files and blobstore.metrics.reconcile
+// ----------------------------------------
+type TaskPropertiesBlobstore struct {
+	BlobstoreName types.String `tfsdk:"blob_store_name" nxrm:"blobstoreName"`
+}
+
+func (p *TaskPropertiesBlobstore) GetFilteredPropertiesAsMap(version common.SystemVersion) *map[string]string {
+	return StructToMap(p)
+}
+
+// Task Blobstore (Blob Store only)
+// ----------------------------------------
+type TaskBlobstoreModel struct {
+	BaseTaskModel
+	Properties *TaskPropertiesBlobstore `tfsdk:"properties"`
+}
+
+func (m *TaskBlobstoreModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
+func (m *TaskBlobstoreModel) ToApiCreateModel(taskType common.TaskType, version common.SystemVersion) *common.TaskCreateApiModel {
+	api := m.toApiCreateModel()
+	api.Type = taskType.String()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+func (m *TaskBlobstoreModel) ToApiUpdateModel(version common.SystemVersion) *common.TaskUpdateApiModel {
+	api := m.toApiUpdateModel()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+// Properties for blobstore.planReconciliation
+// ----------------------------------------
+type TaskPropertiesBlobstorePlanReconciliation struct {
+	BlobstoreName types.String `tfsdk:"blob_store_name" nxrm:"blobstoreName"`
+	SinceDays     types.Int32  `tfsdk:"since_days" nxrm:"sinceDays"`
+	StartDate     types.String `tfsdk:"start_date" nxrm:"startDate"`
+	EndDate       types.String `tfsdk:"end_date" nxrm:"endDate"`
+}
+
+func (p *TaskPropertiesBlobstorePlanReconciliation) GetFilteredPropertiesAsMap(version common.SystemVersion) *map[string]string {
+	properties := StructToMap(p)
+
+	// Only the period that was configured is sent - either since days or a date range
+	if p.SinceDays.IsNull() {
+		delete(*properties, "sinceDays")
+	}
+	if p.StartDate.IsNull() {
+		delete(*properties, "startDate")
+	}
+	if p.EndDate.IsNull() {
+		delete(*properties, "endDate")
+	}
+
+	return properties
+}
+
+// Task Blobstore Plan Reconciliation
+// ----------------------------------------
+type TaskBlobstorePlanReconciliationModel struct {
+	BaseTaskModel
+	Properties *TaskPropertiesBlobstorePlanReconciliation `tfsdk:"properties"`
+}
+
+func (m *TaskBlobstorePlanReconciliationModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
+func (m *TaskBlobstorePlanReconciliationModel) ToApiCreateModel(version common.SystemVersion) *common.TaskCreateApiModel {
+	api := m.toApiCreateModel()
+	api.Type = common.TASK_TYPE_BLOBSTORE_PLANRECONCILIATION.String()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+func (m *TaskBlobstorePlanReconciliationModel) ToApiUpdateModel(version common.SystemVersion) *common.TaskUpdateApiModel {
+	api := m.toApiUpdateModel()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+// Properties for blobstore.executeReconciliationPlan
+// ----------------------------------------
+type TaskPropertiesBlobstoreExecuteReconciliationPlan struct {
+	BlobstoreName types.String `tfsdk:"blob_store_name" nxrm:"blobstoreName"`
+	PlanId        types.String `tfsdk:"plan_id" nxrm:"planId"`
+}
+
+func (p *TaskPropertiesBlobstoreExecuteReconciliationPlan) GetFilteredPropertiesAsMap(version common.SystemVersion) *map[string]string {
+	properties := StructToMap(p)
+
+	// Without a Plan ID, all pending Plans for the Blob Store are executed
+	if p.PlanId.IsNull() {
+		delete(*properties, "planId")
+	}
+
+	return properties
+}
+
+// Task Blobstore Execute Reconciliation Plan
+// ----------------------------------------
+type TaskBlobstoreExecuteReconciliationPlanModel struct {
+	BaseTaskModel
+	Properties *TaskPropertiesBlobstoreExecuteReconciliationPlan `tfsdk:"properties"`
+}
+
+func (m *TaskBlobstoreExecuteReconciliationPlanModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
+func (m *TaskBlobstoreExecuteReconciliationPlanModel) ToApiCreateModel(version common.SystemVersion) *common.TaskCreateApiModel {
+	api := m.toApiCreateModel()
+	api.Type = common.TASK_TYPE_BLOBSTORE_EXECUTERECONCILIATIONPLAN.String()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+func (m *TaskBlobstoreExecuteReconciliationPlanModel) ToApiUpdateModel(version common.SystemVersion) *common.TaskUpdateApiModel {
+	api := m.toApiUpdateModel()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model_test
+
+import (
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskPropertiesBlobstorePlanReconciliationOnlySendsConfiguredPeriod(t *testing.T) {
+	testCases := []struct {
+		name       string
+		properties model.TaskPropertiesBlobstorePlanReconciliation
+		expected   map[string]string
+	}{
+		{
+			name: "whole blob store",
+			properties: model.TaskPropertiesBlobstorePlanReconciliation{
+				BlobstoreName: types.StringValue("default"),
+				SinceDays:     types.Int32Null(),
+				StartDate:     types.StringNull(),
+				EndDate:       types.StringNull(),
+			},
+			expected: map[string]string{"blobstoreName": "default"},
+		},
+		{
+			name: "since days",
+			properties: model.TaskPropertiesBlobstorePlanReconciliation{
+				BlobstoreName: types.StringValue("default"),
+				SinceDays:     types.Int32Value(7),
+				StartDate:     types.StringNull(),
+				EndDate:       types.StringNull(),
+			},
+			expected: map[string]string{"blobstoreName": "default", "sinceDays": "7"},
+		},
+		{
+			name: "date range",
+			properties: model.TaskPropertiesBlobstorePlanReconciliation{
+				BlobstoreName: types.StringValue("default"),
+				SinceDays:     types.Int32Null(),
+				StartDate:     types.StringValue("2026-01-01"),
+				EndDate:       types.StringValue("2026-01-31"),
+			},
+			expected: map[string]string{"blobstoreName": "default", "startDate": "2026-01-01", "endDate": "2026-01-31"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, *tc.properties.GetFilteredPropertiesAsMap(common.SystemVersion{}))
+		})
+	}
+}
+
+func TestTaskBlobstoreModelToApiCreateModel(t *testing.T) {
+	m := model.TaskBlobstoreModel{
+		Properties: &model.TaskPropertiesBlobstore{
+			BlobstoreName: types.StringValue("default"),
+		},
+	}
+
+	api := m.ToApiCreateModel(common.TASK_TYPE_BLOBSTORE_DELETE_TEMP_FILES, common.SystemVersion{})
+
+	assert.Equal(t, common.TASK_TYPE_BLOBSTORE_DELETE_TEMP_FILES.String(), api.Type)
+	assert.Equal(t, map[string]string{"blobstoreName": "default"}, *api.Properties)
+}
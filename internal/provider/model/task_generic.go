@@ -0,0 +1,69 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"terraform-provider-sonatyperepo/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Task of any type - properties are passed to the Tasks API as given
+// ----------------------------------------
+type TaskGenericModel struct {
+	BaseTaskModel
+	Type       types.String            `tfsdk:"type"`
+	Properties map[string]types.String `tfsdk:"properties"`
+}
+
+func (m *TaskGenericModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	if api.Type != nil {
+		m.Type = types.StringPointerValue(api.Type)
+	}
+
+	// Sonatype Nexus Repository returns properties that were never configured (defaults and internal
+	// values), so only those already in state are refreshed
+	if api.Properties != nil {
+		for k := range m.Properties {
+			if v, ok := (*api.Properties)[k]; ok {
+				m.Properties[k] = types.StringValue(v)
+			}
+		}
+	}
+}
+
+func (m *TaskGenericModel) ToApiCreateModel() *common.TaskCreateApiModel {
+	api := m.toApiCreateModel()
+	api.Type = m.Type.ValueString()
+	api.Properties = m.propertiesAsMap()
+	return api
+}
+
+func (m *TaskGenericModel) ToApiUpdateModel() *common.TaskUpdateApiModel {
+	api := m.toApiUpdateModel()
+	api.Properties = m.propertiesAsMap()
+	return api
+}
+
+func (m *TaskGenericModel) propertiesAsMap() *map[string]string {
+	properties := make(map[string]string, len(m.Properties))
+	for k, v := range m.Properties {
+		properties[k] = v.ValueString()
+	}
+	return &properties
+}
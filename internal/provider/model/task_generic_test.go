@@ -0,0 +1,80 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model_test
+
+import (
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskGenericModelToApiCreateModel(t *testing.T) {
+	m := model.TaskGenericModel{
+		Type: types.StringValue(common.TASK_TYPE_REPOSITORY_NPM_REBUILD_METADATA.String()),
+		Properties: map[string]types.String{
+			"repositoryName": types.StringValue("npm-hosted"),
+		},
+	}
+	m.Name = types.StringValue("rebuild-npm")
+	m.Enabled = types.BoolValue(true)
+	m.NotificationCondition = types.StringValue(common.NOTIFICATION_CONDITION_FAILURE)
+
+	api := m.ToApiCreateModel()
+
+	assert.Equal(t, "rebuild-npm", api.Name)
+	assert.Equal(t, common.TASK_TYPE_REPOSITORY_NPM_REBUILD_METADATA.String(), api.Type)
+	assert.Equal(t, map[string]string{"repositoryName": "npm-hosted"}, *api.Properties)
+}
+
+func TestTaskGenericModelMapFromApiKeepsProperties(t *testing.T) {
+	m := model.TaskGenericModel{
+		Properties: map[string]types.String{
+			"repositoryName": types.StringValue("npm-hosted"),
+		},
+	}
+
+	m.MapFromApi(&common.TaskApiModel{
+		Id:   common.StringPointer("abc"),
+		Name: common.StringPointer("rebuild-npm"),
+		Type: common.StringPointer(common.TASK_TYPE_REPOSITORY_NPM_REBUILD_METADATA.String()),
+	})
+
+	assert.Equal(t, "abc", m.Id.ValueString())
+	assert.Equal(t, common.TASK_TYPE_REPOSITORY_NPM_REBUILD_METADATA.String(), m.Type.ValueString())
+	assert.Equal(t, "npm-hosted", m.Properties["repositoryName"].ValueString())
+}
+
+func TestTaskGenericModelMapFromApiRefreshesConfiguredProperties(t *testing.T) {
+	m := model.TaskGenericModel{
+		Properties: map[string]types.String{
+			"repositoryName": types.StringValue("npm-hosted"),
+		},
+	}
+
+	m.MapFromApi(&common.TaskApiModel{
+		Id: common.StringPointer("abc"),
+		Properties: &map[string]string{
+			"repositoryName": "npm-proxy",
+			".typeId":        common.TASK_TYPE_REPOSITORY_NPM_REBUILD_METADATA.String(),
+		},
+	})
+
+	assert.Equal(t, map[string]types.String{"repositoryName": types.StringValue("npm-proxy")}, m.Properties)
+}
@@ -0,0 +1,62 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"terraform-provider-sonatyperepo/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Properties for h2.backup.task
+// ----------------------------------------
+type TaskPropertiesH2Backup struct {
+	Location types.String `tfsdk:"location" nxrm:"location"`
+}
+
+func (p *TaskPropertiesH2Backup) GetFilteredPropertiesAsMap(version common.SystemVersion) *map[string]string {
+	return StructToMap(p)
+}
+
+// Task H2 Backup
+// ----------------------------------------
+type TaskH2BackupModel struct {
+	BaseTaskModel
+	Properties *TaskPropertiesH2Backup `tfsdk:"properties"`
+}
+
+func (m *TaskH2BackupModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
+func (m *TaskH2BackupModel) ToApiCreateModel(version common.SystemVersion) *common.TaskCreateApiModel {
+	api := m.toApiCreateModel()
+	api.Type = common.TASK_TYPE_H2_BACKUP_TASK.String()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+func (m *TaskH2BackupModel) ToApiUpdateModel(version common.SystemVersion) *common.TaskUpdateApiModel {
+	api := m.toApiUpdateModel()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
@@ -40,6 +40,11 @@ type TaskMalwareRemediationModel struct {
 	Properties *TaskPropertiesMalwareRemediation `tfsdk:"properties"`
 }
 
+func (m *TaskMalwareRemediationModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
 func (m *TaskMalwareRemediationModel) ToApiCreateModel(version common.SystemVersion) *common.TaskCreateApiModel {
 	api := m.toApiCreateModel()
 	api.Type = common.TASK_TYPE_MALWARE_REMEDIATOR.String()
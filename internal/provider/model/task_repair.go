@@ -39,6 +39,11 @@ type TaskRepairCreateBrowseNodesModel struct {
 	Properties *TaskPropertiesRepairCreateBrowseNodes `tfsdk:"properties"`
 }
 
+func (m *TaskRepairCreateBrowseNodesModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
 func (m *TaskRepairCreateBrowseNodesModel) ToApiCreateModel(version common.SystemVersion) *common.TaskCreateApiModel {
 	api := m.toApiCreateModel()
 	api.Type = common.TASK_TYPE_CREATE_BROWSE_NODES.String()
@@ -40,6 +40,11 @@ type TaskRepositoryDockerGcModel struct {
 	Properties *TaskPropertiesRepositoryDockerGc `tfsdk:"properties"`
 }
 
+func (m *TaskRepositoryDockerGcModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
 func (m *TaskRepositoryDockerGcModel) ToApiCreateModel(version common.SystemVersion) *common.TaskCreateApiModel {
 	api := m.toApiCreateModel()
 	api.Type = common.TASK_TYPE_REPOSITORY_DOCKER_GC.String()
@@ -74,6 +79,11 @@ type TaskRepositoryDockerUploadPurgeModel struct {
 	Properties *TaskPropertiesRepositoryDockerUploadPurge `tfsdk:"properties"`
 }
 
+func (m *TaskRepositoryDockerUploadPurgeModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
 func (m *TaskRepositoryDockerUploadPurgeModel) ToApiCreateModel(version common.SystemVersion) *common.TaskCreateApiModel {
 	api := m.toApiCreateModel()
 	api.Type = common.TASK_TYPE_REPOSITORY_DOCKER_UPLOAD_PURGE.String()
@@ -118,6 +128,11 @@ type TaskRepositoryMavenRemoveSnapshotsModel struct {
 	Properties *TaskPropertiesRepositoryMavenRemoveSnapshots `tfsdk:"properties"`
 }
 
+func (m *TaskRepositoryMavenRemoveSnapshotsModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
 func (m *TaskRepositoryMavenRemoveSnapshotsModel) ToApiCreateModel(version common.SystemVersion) *common.TaskCreateApiModel {
 	api := m.toApiCreateModel()
 	api.Type = common.TASK_TYPE_REPOSITORY_MAVEN_REMOVE_SNAPSHOTS.String()
@@ -134,3 +149,223 @@ func (m *TaskRepositoryMavenRemoveSnapshotsModel) ToApiUpdateModel(version commo
 	}
 	return api
 }
+
+// Properties for Tasks that rebuild metadata or indexes for a single Repository
+//
+// Applies to repository.apt.rebuild.metadata, repository.helm.rebuild.metadata, repository.npm.rebuild-metadata,
+// repository.pypi.rebuild-metadata, repository.rebuild-index, repository.ruby.rebuild.versions and
+// repository.yum.rebuild.metadata
+// ----------------------------------------
+type TaskPropertiesRepositoryRebuild struct {
+	RepositoryName types.String `tfsdk:"repository_name" nxrm:"repositoryName"`
+}
+
+func (p *TaskPropertiesRepositoryRebuild) GetFilteredPropertiesAsMap(version common.SystemVersion) *map[string]string {
+	return StructToMap(p)
+}
+
+// Task Repository Rebuild (metadata or index)
+// ----------------------------------------
+type TaskRepositoryRebuildModel struct {
+	BaseTaskModel
+	Properties *TaskPropertiesRepositoryRebuild `tfsdk:"properties"`
+}
+
+func (m *TaskRepositoryRebuildModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
+func (m *TaskRepositoryRebuildModel) ToApiCreateModel(taskType common.TaskType, version common.SystemVersion) *common.TaskCreateApiModel {
+	api := m.toApiCreateModel()
+	api.Type = taskType.String()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+func (m *TaskRepositoryRebuildModel) ToApiUpdateModel(version common.SystemVersion) *common.TaskUpdateApiModel {
+	api := m.toApiUpdateModel()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+// Properties for repository.maven.rebuild-metadata
+// ----------------------------------------
+type TaskPropertiesRepositoryMavenRebuildMetadata struct {
+	RepositoryName   types.String `tfsdk:"repository_name" nxrm:"repositoryName"`
+	GroupId          types.String `tfsdk:"group_id" nxrm:"groupId"`
+	ArtifactId       types.String `tfsdk:"artifact_id" nxrm:"artifactId"`
+	BaseVersion      types.String `tfsdk:"base_version" nxrm:"baseVersion"`
+	RebuildChecksums types.Bool   `tfsdk:"rebuild_checksums" nxrm:"rebuildChecksums"`
+}
+
+func (p *TaskPropertiesRepositoryMavenRebuildMetadata) GetFilteredPropertiesAsMap(version common.SystemVersion) *map[string]string {
+	properties := StructToMap(p)
+
+	// GAV filters are only sent when set - an empty filter would match nothing
+	for _, k := range []string{"groupId", "artifactId", "baseVersion"} {
+		if (*properties)[k] == "" {
+			delete(*properties, k)
+		}
+	}
+
+	return properties
+}
+
+// Task Repository Maven Rebuild Metadata
+// ----------------------------------------
+type TaskRepositoryMavenRebuildMetadataModel struct {
+	BaseTaskModel
+	Properties *TaskPropertiesRepositoryMavenRebuildMetadata `tfsdk:"properties"`
+}
+
+func (m *TaskRepositoryMavenRebuildMetadataModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
+func (m *TaskRepositoryMavenRebuildMetadataModel) ToApiCreateModel(version common.SystemVersion) *common.TaskCreateApiModel {
+	api := m.toApiCreateModel()
+	api.Type = common.TASK_TYPE_REPOSITORY_MAVEN_REBUILD_METADATA.String()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+func (m *TaskRepositoryMavenRebuildMetadataModel) ToApiUpdateModel(version common.SystemVersion) *common.TaskUpdateApiModel {
+	api := m.toApiUpdateModel()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+// Properties for repository.export
+// ----------------------------------------
+type TaskPropertiesRepositoryExport struct {
+	RepositoryName types.String `tfsdk:"repository_name" nxrm:"repositoryName"`
+	FilePath       types.String `tfsdk:"file_path" nxrm:"filePath"`
+}
+
+func (p *TaskPropertiesRepositoryExport) GetFilteredPropertiesAsMap(version common.SystemVersion) *map[string]string {
+	return StructToMap(p)
+}
+
+// Task Repository Export
+// ----------------------------------------
+type TaskRepositoryExportModel struct {
+	BaseTaskModel
+	Properties *TaskPropertiesRepositoryExport `tfsdk:"properties"`
+}
+
+func (m *TaskRepositoryExportModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
+func (m *TaskRepositoryExportModel) ToApiCreateModel(version common.SystemVersion) *common.TaskCreateApiModel {
+	api := m.toApiCreateModel()
+	api.Type = common.TASK_TYPE_REPOSITORY_EXPORT.String()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+func (m *TaskRepositoryExportModel) ToApiUpdateModel(version common.SystemVersion) *common.TaskUpdateApiModel {
+	api := m.toApiUpdateModel()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+// Properties for repository.import
+// ----------------------------------------
+type TaskPropertiesRepositoryImport struct {
+	RepositoryName    types.String `tfsdk:"repository_name" nxrm:"repositoryName"`
+	FilePath          types.String `tfsdk:"file_path" nxrm:"filePath"`
+	HardLinksEnabled  types.Bool   `tfsdk:"hard_links_enabled" nxrm:"hardLinksEnabled"`
+	DeleteSourceFiles types.Bool   `tfsdk:"delete_source_files" nxrm:"deleteSourceFiles"`
+	DryRun            types.Bool   `tfsdk:"dry_run" nxrm:"dryRun"`
+}
+
+func (p *TaskPropertiesRepositoryImport) GetFilteredPropertiesAsMap(version common.SystemVersion) *map[string]string {
+	return StructToMap(p)
+}
+
+// Task Repository Import
+// ----------------------------------------
+type TaskRepositoryImportModel struct {
+	BaseTaskModel
+	Properties *TaskPropertiesRepositoryImport `tfsdk:"properties"`
+}
+
+func (m *TaskRepositoryImportModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
+func (m *TaskRepositoryImportModel) ToApiCreateModel(version common.SystemVersion) *common.TaskCreateApiModel {
+	api := m.toApiCreateModel()
+	api.Type = common.TASK_TYPE_REPOSITORY_IMPORT.String()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+func (m *TaskRepositoryImportModel) ToApiUpdateModel(version common.SystemVersion) *common.TaskUpdateApiModel {
+	api := m.toApiUpdateModel()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+// Properties for Tasks that delete components not used for a number of days
+//
+// Applies to repository.purge-unused and repository.maven.purge-unused-snapshots
+// ----------------------------------------
+type TaskPropertiesRepositoryPurgeUnused struct {
+	RepositoryName types.String `tfsdk:"repository_name" nxrm:"repositoryName"`
+	LastUsed       types.Int32  `tfsdk:"last_used" nxrm:"lastUsed"`
+}
+
+func (p *TaskPropertiesRepositoryPurgeUnused) GetFilteredPropertiesAsMap(version common.SystemVersion) *map[string]string {
+	return StructToMap(p)
+}
+
+// Task Repository Purge Unused
+// ----------------------------------------
+type TaskRepositoryPurgeUnusedModel struct {
+	BaseTaskModel
+	Properties *TaskPropertiesRepositoryPurgeUnused `tfsdk:"properties"`
+}
+
+func (m *TaskRepositoryPurgeUnusedModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
+func (m *TaskRepositoryPurgeUnusedModel) ToApiCreateModel(taskType common.TaskType, version common.SystemVersion) *common.TaskCreateApiModel {
+	api := m.toApiCreateModel()
+	api.Type = taskType.String()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+func (m *TaskRepositoryPurgeUnusedModel) ToApiUpdateModel(version common.SystemVersion) *common.TaskUpdateApiModel {
+	api := m.toApiUpdateModel()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
@@ -0,0 +1,93 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model_test
+
+import (
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskRepositoryRebuildModelToApiCreateModel(t *testing.T) {
+	m := model.TaskRepositoryRebuildModel{
+		Properties: &model.TaskPropertiesRepositoryRebuild{
+			RepositoryName: types.StringValue("*"),
+		},
+	}
+	m.Name = types.StringValue("rebuild-index")
+
+	api := m.ToApiCreateModel(common.TASK_TYPE_REPOSITORY_REBUILD_INDEX, common.SystemVersion{})
+
+	assert.Equal(t, common.TASK_TYPE_REPOSITORY_REBUILD_INDEX.String(), api.Type)
+	assert.Equal(t, map[string]string{"repositoryName": "*"}, *api.Properties)
+}
+
+func TestTaskPropertiesRepositoryMavenRebuildMetadataOmitsUnsetFilters(t *testing.T) {
+	p := model.TaskPropertiesRepositoryMavenRebuildMetadata{
+		RepositoryName:   types.StringValue("maven-releases"),
+		GroupId:          types.StringValue("org.apache.commons"),
+		ArtifactId:       types.StringNull(),
+		BaseVersion:      types.StringNull(),
+		RebuildChecksums: types.BoolValue(true),
+	}
+
+	assert.Equal(t, map[string]string{
+		"repositoryName":   "maven-releases",
+		"groupId":          "org.apache.commons",
+		"rebuildChecksums": "true",
+	}, *p.GetFilteredPropertiesAsMap(common.SystemVersion{}))
+}
+
+func TestTaskRepositoryImportModelToApiCreateModel(t *testing.T) {
+	m := model.TaskRepositoryImportModel{
+		Properties: &model.TaskPropertiesRepositoryImport{
+			RepositoryName:    types.StringValue("npm-hosted"),
+			FilePath:          types.StringValue("/var/imports/npm"),
+			HardLinksEnabled:  types.BoolValue(true),
+			DeleteSourceFiles: types.BoolValue(false),
+			DryRun:            types.BoolValue(false),
+		},
+	}
+
+	api := m.ToApiCreateModel(common.SystemVersion{})
+
+	assert.Equal(t, common.TASK_TYPE_REPOSITORY_IMPORT.String(), api.Type)
+	assert.Equal(t, map[string]string{
+		"repositoryName":    "npm-hosted",
+		"filePath":          "/var/imports/npm",
+		"hardLinksEnabled":  "true",
+		"deleteSourceFiles": "false",
+		"dryRun":            "false",
+	}, *api.Properties)
+}
+
+func TestTaskRepositoryPurgeUnusedModelToApiCreateModel(t *testing.T) {
+	m := model.TaskRepositoryPurgeUnusedModel{
+		Properties: &model.TaskPropertiesRepositoryPurgeUnused{
+			RepositoryName: types.StringValue("maven-central"),
+			LastUsed:       types.Int32Value(30),
+		},
+	}
+
+	api := m.ToApiCreateModel(common.TASK_TYPE_REPOSITORY_PURGE_UNUSED, common.SystemVersion{})
+
+	assert.Equal(t, common.TASK_TYPE_REPOSITORY_PURGE_UNUSED.String(), api.Type)
+	assert.Equal(t, map[string]string{"repositoryName": "maven-central", "lastUsed": "30"}, *api.Properties)
+}
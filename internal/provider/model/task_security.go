@@ -0,0 +1,37 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"terraform-provider-sonatyperepo/internal/provider/common"
+)
+
+// Task Security Purge API Keys
+// ----------------------------------------
+type TaskSecurityPurgeApiKeysModel struct {
+	BaseTaskModel
+}
+
+func (m *TaskSecurityPurgeApiKeysModel) ToApiCreateModel() *common.TaskCreateApiModel {
+	api := m.toApiCreateModel()
+	api.Type = common.TASK_TYPE_SECURITY_PURGE_API_KEYS.String()
+	return api
+}
+
+func (m *TaskSecurityPurgeApiKeysModel) ToApiUpdateModel() *common.TaskUpdateApiModel {
+	return m.toApiUpdateModel()
+}
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model
+
+import (
+	"terraform-provider-sonatyperepo/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Properties for tags.cleanup
+// ----------------------------------------
+type TaskPropertiesTagsCleanup struct {
+	RepositoryName             types.String `tfsdk:"repository_name" nxrm:"repositoryName"`
+	OlderThan                  types.Int32  `tfsdk:"older_than" nxrm:"olderThan"`
+	TagNamePattern             types.String `tfsdk:"tag_name_pattern" nxrm:"tagNamePattern"`
+	DeleteAssociatedComponents types.Bool   `tfsdk:"delete_associated_components" nxrm:"deleteAssociatedComponents"`
+}
+
+func (p *TaskPropertiesTagsCleanup) GetFilteredPropertiesAsMap(version common.SystemVersion) *map[string]string {
+	properties := StructToMap(p)
+
+	// Only the criteria that were configured are sent
+	if p.OlderThan.IsNull() {
+		delete(*properties, "olderThan")
+	}
+	if p.TagNamePattern.IsNull() {
+		delete(*properties, "tagNamePattern")
+	}
+
+	return properties
+}
+
+// Task Tags Cleanup
+// ----------------------------------------
+type TaskTagsCleanupModel struct {
+	BaseTaskModel
+	Properties *TaskPropertiesTagsCleanup `tfsdk:"properties"`
+}
+
+func (m *TaskTagsCleanupModel) MapFromApi(api *common.TaskApiModel) {
+	m.BaseTaskModel.MapFromApi(api)
+	m.Properties = mapTaskPropertiesFromApi(m.Properties, api)
+}
+
+func (m *TaskTagsCleanupModel) ToApiCreateModel(version common.SystemVersion) *common.TaskCreateApiModel {
+	api := m.toApiCreateModel()
+	api.Type = common.TASK_TYPE_TAGS_CLEANUP.String()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
+
+func (m *TaskTagsCleanupModel) ToApiUpdateModel(version common.SystemVersion) *common.TaskUpdateApiModel {
+	api := m.toApiUpdateModel()
+	if m.Properties != nil {
+		api.Properties = m.Properties.GetFilteredPropertiesAsMap(version)
+	}
+	return api
+}
@@ -0,0 +1,41 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model_test
+
+import (
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskPropertiesTagsCleanupOnlySendsConfiguredCriteria(t *testing.T) {
+	p := model.TaskPropertiesTagsCleanup{
+		RepositoryName:             types.StringValue(common.TASK_TAGS_CLEANUP_ALL_REPOSITORIES),
+		OlderThan:                  types.Int32Value(90),
+		TagNamePattern:             types.StringNull(),
+		DeleteAssociatedComponents: types.BoolValue(false),
+	}
+
+	assert.Equal(t, map[string]string{
+		"repositoryName":             "*",
+		"olderThan":                  "90",
+		"deleteAssociatedComponents": "false",
+	}, *p.GetFilteredPropertiesAsMap(common.SystemVersion{}))
+}
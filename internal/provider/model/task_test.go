@@ -0,0 +1,322 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package model_test
+
+import (
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTaskMapFromApiRefreshesConfiguration(t *testing.T) {
+	m := model.TaskBlobstoreCompactModel{
+		Properties: &model.TaskPropertiesBlobstoreCompact{
+			BlobstoreName:  types.StringValue("default"),
+			BlobsOlderThan: types.Int32Value(7),
+		},
+	}
+	m.Enabled = types.BoolValue(true)
+	m.AlertEmail = types.StringNull()
+	m.NotificationCondition = types.StringValue(common.NOTIFICATION_CONDITION_FAILURE)
+	enabled := false
+	startDate := int64(1777167000000)
+
+	m.MapFromApi(&common.TaskApiModel{
+		Id:                    common.StringPointer("abc"),
+		Name:                  common.StringPointer("compact"),
+		Enabled:               &enabled,
+		AlertEmail:            common.StringPointer(""),
+		NotificationCondition: common.StringPointer(common.NOTIFICATION_CONDITION_SUCCESS_OR_FAILURE),
+		Schedule:              common.StringPointer(common.FREQUENCY_SCHEDULE_WEEKLY),
+		StartDate:             &startDate,
+		TimeZoneOffset:        common.StringPointer("+00:00"),
+		RecurringDays:         []int32{1, 5},
+		Properties: &map[string]string{
+			"blobstoreName":  "default",
+			"blobsOlderThan": "14",
+		},
+	})
+
+	assert.Equal(t, "abc", m.Id.ValueString())
+	assert.False(t, m.Enabled.ValueBool())
+	assert.True(t, m.AlertEmail.IsNull())
+	assert.Equal(t, common.NOTIFICATION_CONDITION_SUCCESS_OR_FAILURE, m.NotificationCondition.ValueString())
+	assert.Equal(t, common.FREQUENCY_SCHEDULE_WEEKLY, m.Frequency.Schedule.ValueString())
+	assert.Equal(t, int64(1777167000000), m.Frequency.StartDate.ValueInt64())
+	assert.Equal(t, []types.Int32{types.Int32Value(1), types.Int32Value(5)}, m.Frequency.RecurringDays)
+	assert.Equal(t, "default", m.Properties.BlobstoreName.ValueString())
+	assert.Equal(t, int32(14), m.Properties.BlobsOlderThan.ValueInt32())
+}
+
+func TestTaskMapFromApiKeepsUnmanagedFrequencyFields(t *testing.T) {
+	var m model.TaskBlobstoreCompactModel
+	m.MapFromApi(&common.TaskApiModel{
+		Id:       common.StringPointer("abc"),
+		Schedule: common.StringPointer(common.FREQUENCY_SCHEDULE_DAILY),
+	})
+	m.Frequency.StartDate = types.Int64Null()
+	m.Frequency.TimezoneOffset = types.StringNull()
+	m.Frequency.RecurringDays = []types.Int32{types.Int32Value(5), types.Int32Value(1)}
+	startDate := int64(1777167000000)
+
+	m.MapFromApi(&common.TaskApiModel{
+		Id:             common.StringPointer("abc"),
+		Schedule:       common.StringPointer(common.FREQUENCY_SCHEDULE_DAILY),
+		StartDate:      &startDate,
+		TimeZoneOffset: common.StringPointer("+00:00"),
+		RecurringDays:  []int32{1, 5},
+		CronExpression: common.StringPointer(""),
+	})
+
+	assert.True(t, m.Frequency.StartDate.IsNull())
+	assert.True(t, m.Frequency.TimezoneOffset.IsNull())
+	assert.True(t, m.Frequency.CronExpression.IsNull())
+	assert.Equal(t, []types.Int32{types.Int32Value(5), types.Int32Value(1)}, m.Frequency.RecurringDays)
+}
+
+func TestTaskMapFromApiKeepsNullProperties(t *testing.T) {
+	m := model.TaskTagsCleanupModel{
+		Properties: &model.TaskPropertiesTagsCleanup{
+			RepositoryName:             types.StringValue("*"),
+			OlderThan:                  types.Int32Value(30),
+			TagNamePattern:             types.StringNull(),
+			DeleteAssociatedComponents: types.BoolValue(false),
+		},
+	}
+
+	m.MapFromApi(&common.TaskApiModel{
+		Id: common.StringPointer("abc"),
+		Properties: &map[string]string{
+			"repositoryName":             "*",
+			"olderThan":                  "60",
+			"tagNamePattern":             "",
+			"deleteAssociatedComponents": "true",
+		},
+	})
+
+	assert.Equal(t, int32(60), m.Properties.OlderThan.ValueInt32())
+	assert.True(t, m.Properties.TagNamePattern.IsNull())
+	assert.True(t, m.Properties.DeleteAssociatedComponents.ValueBool())
+}
+
+func TestTaskModelSimpleMapFromApiIncludesRuntimeStatus(t *testing.T) {
+	lastRun := time.Date(2026, 10, 1, 2, 0, 0, 0, time.UTC)
+	var m model.TaskModelSimple
+
+	m.MapFromApi(&common.TaskApiModel{
+		Id:            common.StringPointer("abc"),
+		Name:          common.StringPointer("compact"),
+		Type:          common.StringPointer(common.TASK_TYPE_BLOBSTORE_COMPACT.String()),
+		CurrentState:  common.StringPointer(common.TASK_CURRENT_STATE_WAITING),
+		LastRun:       &lastRun,
+		LastRunResult: common.StringPointer("FAILED"),
+	})
+
+	assert.Equal(t, common.TASK_CURRENT_STATE_WAITING, m.CurrentState.ValueString())
+	assert.Equal(t, "2026-10-01T02:00:00Z", m.LastRun.ValueString())
+	assert.Equal(t, "FAILED", m.LastRunResult.ValueString())
+	assert.True(t, m.NextRun.IsNull())
+	assert.True(t, m.Message.IsNull())
+}
+
+func TestTasksModelMatches(t *testing.T) {
+	task := common.TaskApiModel{
+		Type:         common.StringPointer(common.TASK_TYPE_BLOBSTORE_COMPACT.String()),
+		CurrentState: common.StringPointer(common.TASK_CURRENT_STATE_RUNNING),
+	}
+
+	testCases := []struct {
+		name         string
+		taskType     types.String
+		currentState types.String
+		expected     bool
+	}{
+		{"no filters", types.StringNull(), types.StringNull(), true},
+		{"matching type", types.StringValue(common.TASK_TYPE_BLOBSTORE_COMPACT.String()), types.StringNull(), true},
+		{"other type", types.StringValue(common.TASK_TYPE_REPOSITORY_DOCKER_GC.String()), types.StringNull(), false},
+		{"matching type and state", types.StringValue(common.TASK_TYPE_BLOBSTORE_COMPACT.String()), types.StringValue(common.TASK_CURRENT_STATE_RUNNING), true},
+		{"other state", types.StringNull(), types.StringValue(common.TASK_CURRENT_STATE_WAITING), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := model.TasksModel{Type: tc.taskType, CurrentState: tc.currentState}
+			assert.Equal(t, tc.expected, m.Matches(&task))
+		})
+	}
+}
+
+func TestTaskFrequencyNextFireTimes(t *testing.T) {
+	// Sunday 18 October 2026, 10:30:00 UTC
+	after := time.Date(2026, 10, 18, 10, 30, 0, 0, time.UTC)
+	// Friday 16 October 2026, 01:00:00 UTC
+	startDate := types.Int64Value(time.Date(2026, 10, 16, 1, 0, 0, 0, time.UTC).UnixMilli())
+
+	testCases := []struct {
+		name      string
+		frequency model.TaskFrequency
+		expected  []string
+	}{
+		{
+			name:      "manual",
+			frequency: model.TaskFrequency{Schedule: types.StringValue(common.FREQUENCY_SCHEDULE_MANUAL)},
+			expected:  []string{},
+		},
+		{
+			name:      "once in the past",
+			frequency: model.TaskFrequency{Schedule: types.StringValue(common.FREQUENCY_SCHEDULE_ONCE), StartDate: startDate},
+			expected:  []string{},
+		},
+		{
+			name:      "hourly",
+			frequency: model.TaskFrequency{Schedule: types.StringValue(common.FREQUENCY_SCHEDULE_HOURLY), StartDate: startDate},
+			expected:  []string{"2026-10-18T11:00:00Z", "2026-10-18T12:00:00Z", "2026-10-18T13:00:00Z"},
+		},
+		{
+			name:      "daily without start date",
+			frequency: model.TaskFrequency{Schedule: types.StringValue(common.FREQUENCY_SCHEDULE_DAILY)},
+			expected:  []string{},
+		},
+		{
+			name: "daily with time zone offset",
+			frequency: model.TaskFrequency{
+				Schedule:       types.StringValue(common.FREQUENCY_SCHEDULE_DAILY),
+				StartDate:      startDate,
+				TimezoneOffset: types.StringValue("-05:00"),
+			},
+			expected: []string{"2026-10-18T20:00:00-05:00", "2026-10-19T20:00:00-05:00", "2026-10-20T20:00:00-05:00"},
+		},
+		{
+			name: "weekly",
+			frequency: model.TaskFrequency{
+				Schedule:      types.StringValue(common.FREQUENCY_SCHEDULE_WEEKLY),
+				StartDate:     startDate,
+				RecurringDays: []types.Int32{types.Int32Value(1), types.Int32Value(5)},
+			},
+			expected: []string{"2026-10-19T01:00:00Z", "2026-10-23T01:00:00Z", "2026-10-26T01:00:00Z"},
+		},
+		{
+			name: "monthly",
+			frequency: model.TaskFrequency{
+				Schedule:      types.StringValue(common.FREQUENCY_SCHEDULE_MONTHLY),
+				StartDate:     startDate,
+				RecurringDays: []types.Int32{types.Int32Value(31)},
+			},
+			expected: []string{"2026-10-31T01:00:00Z", "2026-12-31T01:00:00Z", "2027-01-31T01:00:00Z"},
+		},
+		{
+			name: "cron",
+			frequency: model.TaskFrequency{
+				Schedule:       types.StringValue(common.FREQUENCY_SCHEDULE_CRON),
+				CronExpression: types.StringValue("0 0 2 ? * MON-FRI"),
+			},
+			expected: []string{"2026-10-19T02:00:00Z", "2026-10-20T02:00:00Z", "2026-10-21T02:00:00Z"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := make([]string, 0)
+			for _, next := range tc.frequency.NextFireTimes(after, 3) {
+				actual = append(actual, next.Format(time.RFC3339))
+			}
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+func TestTaskFrequencyPlannedTimezoneOffset(t *testing.T) {
+	// Daily at 03:00 in London
+	london := model.TaskFrequency{
+		Schedule:  types.StringValue(common.FREQUENCY_SCHEDULE_DAILY),
+		StartDate: types.Int64Value(time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC).UnixMilli()),
+		Timezone:  types.StringValue("Europe/London"),
+	}
+
+	testCases := []struct {
+		name      string
+		frequency model.TaskFrequency
+		now       time.Time
+		expected  types.String
+	}{
+		{
+			name:      "timezone during daylight saving time",
+			frequency: london,
+			now:       time.Date(2026, 10, 20, 12, 0, 0, 0, time.UTC),
+			expected:  types.StringValue("+01:00"),
+		},
+		{
+			name:      "timezone once daylight saving time has ended",
+			frequency: london,
+			now:       time.Date(2026, 10, 25, 12, 0, 0, 0, time.UTC),
+			expected:  types.StringValue("+00:00"),
+		},
+		{
+			name: "timezone for a manual schedule",
+			frequency: model.TaskFrequency{
+				Schedule: types.StringValue(common.FREQUENCY_SCHEDULE_MANUAL),
+				Timezone: types.StringValue("America/New_York"),
+			},
+			now:      time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC),
+			expected: types.StringValue("-04:00"),
+		},
+		{
+			name: "timezone offset as given",
+			frequency: model.TaskFrequency{
+				Schedule:       types.StringValue(common.FREQUENCY_SCHEDULE_DAILY),
+				TimezoneOffset: types.StringValue("-05:00"),
+			},
+			now:      time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC),
+			expected: types.StringValue("-05:00"),
+		},
+		{
+			name: "neither given",
+			frequency: model.TaskFrequency{
+				Schedule:       types.StringValue(common.FREQUENCY_SCHEDULE_DAILY),
+				TimezoneOffset: types.StringUnknown(),
+			},
+			now:      time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC),
+			expected: types.StringNull(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, tc.frequency.PlannedTimezoneOffset(tc.now))
+		})
+	}
+}
+
+func TestTaskFrequencyNextFireTimesFollowTimezone(t *testing.T) {
+	// Daily at 03:00 in London - daylight saving time ends on 25 October 2026
+	frequency := model.TaskFrequency{
+		Schedule:  types.StringValue(common.FREQUENCY_SCHEDULE_DAILY),
+		StartDate: types.Int64Value(time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC).UnixMilli()),
+		Timezone:  types.StringValue("Europe/London"),
+	}
+
+	actual := make([]string, 0)
+	for _, next := range frequency.NextFireTimes(time.Date(2026, 10, 23, 12, 0, 0, 0, time.UTC), 3) {
+		actual = append(actual, next.Format(time.RFC3339))
+	}
+
+	assert.Equal(t, []string{"2026-10-24T03:00:00+01:00", "2026-10-25T03:00:00Z", "2026-10-26T03:00:00Z"}, actual)
+}
@@ -27,6 +27,7 @@ import (
 	"terraform-provider-sonatyperepo/internal/provider/blob_store"
 	"terraform-provider-sonatyperepo/internal/provider/capability"
 	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/component"
 	"terraform-provider-sonatyperepo/internal/provider/content_selector"
 	"terraform-provider-sonatyperepo/internal/provider/privilege"
 	"terraform-provider-sonatyperepo/internal/provider/repository"
@@ -354,6 +355,7 @@ func (p *SonatypeRepoProvider) Resources(ctx context.Context) []func() resource.
 		capability.NewCapabilityUiSettingsResource,
 		capability.NewCapabilityWebhookGlobalResource,
 		capability.NewCapabilityWebhookRepositoryResource,
+		component.NewComponentResource,
 		content_selector.NewContentSelectorResource,
 		privilege.NewApplicationPrivilegeResource,
 		privilege.NewRepositoryAdminPrivilegeResource,
@@ -385,6 +387,7 @@ func (p *SonatypeRepoProvider) Resources(ctx context.Context) []func() resource.
 		repository.NewRepositoryGoGroupResource,
 		repository.NewRepositoryGoHostedResource,
 		repository.NewRepositoryGoProxyResource,
+		repository.NewRepositoryGroupMemberResource,
 		repository.NewRepositoryHelmGroupResource,
 		repository.NewRepositoryHelmHostedResource,
 		repository.NewRepositoryHelmProxyResource,
@@ -442,13 +445,33 @@ func (p *SonatypeRepoProvider) Resources(ctx context.Context) []func() resource.
 		system.NewSecuritySsrfProtectionResource,
 		system.NewSecurityUserTokenResource,
 		system.NewSecuritySslTruststoreResource,
+		task.NewTaskResource,
 		task.NewTaskBlobstoreCompactResource,
+		task.NewTaskBlobstoreDeleteTempFilesResource,
+		task.NewTaskBlobstoreExecuteReconciliationPlanResource,
+		task.NewTaskBlobstoreMetricsReconcileResource,
+		task.NewTaskBlobstorePlanReconciliationResource,
+		task.NewTaskH2BackupResource,
 		task.NewTaskLicenseExpirationNotificationResource,
 		task.NewTaskMalwareRemediatorResource,
 		task.NewTaskRepairRebuildBrowseNodesResource,
+		task.NewTaskRepositoryAptRebuildMetadataResource,
 		task.NewTaskRepositoryDockerGcResource,
 		task.NewTaskRepositoryDockerUploadPurgeResource,
+		task.NewTaskRepositoryExportResource,
+		task.NewTaskRepositoryHelmRebuildMetadataResource,
+		task.NewTaskRepositoryImportResource,
+		task.NewTaskRepositoryMavenPurgeUnusedSnapshotsResource,
+		task.NewTaskRepositoryMavenRebuildMetadataResource,
 		task.NewTaskRepositoryMavenRemoveSnapshotsResource,
+		task.NewTaskRepositoryNpmRebuildMetadataResource,
+		task.NewTaskRepositoryPurgeUnusedResource,
+		task.NewTaskRepositoryPypiRebuildMetadataResource,
+		task.NewTaskRepositoryRebuildIndexResource,
+		task.NewTaskRepositoryRubyRebuildVersionsResource,
+		task.NewTaskRepositoryYumRebuildMetadataResource,
+		task.NewTaskSecurityPurgeApiKeysResource,
+		task.NewTaskTagsCleanupResource,
 		user.NewUserResource,
 	}
 }
@@ -458,12 +481,21 @@ func (p *SonatypeRepoProvider) DataSources(ctx context.Context) []func() datasou
 		blob_store.BlobStoresDataSource,
 		blob_store.BlobStoreAcsDataSource,
 		blob_store.BlobStoreFileDataSource,
+		blob_store.BlobStoreGoogleCloudDataSource,
 		blob_store.BlobStoreGroupDataSource,
+		blob_store.BlobStoreQuotaStatusDataSource,
 		blob_store.BlobStoreS3DataSource,
 		capability.CapabilitiesDataSource,
+		component.AssetsDataSource,
+		component.ComponentsDataSource,
 		content_selector.ContentSelectorDataSource,
 		content_selector.ContentSelectorsDataSource,
+		content_selector.ContentSelectorPreviewDataSource,
 		privilege.PrivilegesDataSource,
+		repository.CleanupPolicyDataSource,
+		repository.CleanupPoliciesDataSource,
+		repository.CleanupPolicyPreviewDataSource,
+		repository.DockerConnectorsDataSource,
 		repository.RepositoriesDataSource,
 		repository.RoutingRuleDataSource,
 		repository.RoutingRulesDataSource,
@@ -24,10 +24,17 @@ import (
 	"log"
 	"net/http"
 	"os"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/stretchr/testify/assert"
+
+	"terraform-provider-sonatyperepo/internal/provider"
+	"terraform-provider-sonatyperepo/internal/provider/repository/format"
+
 	v3 "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
 )
 
@@ -66,6 +73,33 @@ func TestMain(m *testing.M) {
 	os.Exit(exitCode)
 }
 
+// TestRepositoryFormatsCoverRepositoryResources ensures that every Repository resource registered with the
+// Provider has its Repository Format listed by format.RepositoryFormats(), which is used to find what
+// depends upon Blob Stores, Cleanup Policies and Routing Rules.
+func TestRepositoryFormatsCoverRepositoryResources(t *testing.T) {
+	ctx := context.Background()
+	repositoryResourceName := regexp.MustCompile(`^sonatyperepo_repository_[a-z0-9]+_(hosted|proxy|group)$`)
+
+	listed := make(map[string]bool)
+	for repoType, formats := range format.RepositoryFormats() {
+		for _, f := range formats {
+			listed["sonatyperepo_"+f.ResourceName(repoType)] = true
+		}
+	}
+
+	registered := 0
+	for _, newResource := range provider.New("test")().Resources(ctx) {
+		resp := resource.MetadataResponse{}
+		newResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "sonatyperepo"}, &resp)
+		if !repositoryResourceName.MatchString(resp.TypeName) {
+			continue
+		}
+		registered++
+		assert.True(t, listed[resp.TypeName], "Repository Format for %s is missing from format.RepositoryFormats()", resp.TypeName)
+	}
+	assert.Equal(t, registered, len(listed), "format.RepositoryFormats() lists Repository Formats that are not registered with the Provider")
+}
+
 func createDefaultBlobStore(nxrmClient *v3.APIClient, ctx *context.Context) {
 	httpResponse, err := nxrmClient.BlobStoreAPI.CreateFileBlobStore(*ctx).Body(
 		v3.FileBlobStoreApiCreateRequest{
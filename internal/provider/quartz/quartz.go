@@ -0,0 +1,389 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package quartz parses Quartz cron expressions, as used by Sonatype Nexus Repository for Tasks with a
+// "cron" schedule, so that invalid expressions are reported during `terraform validate` rather than when
+// applied - and so that the times a Task will run can be shown in a plan.
+//
+// An expression has six or seven fields, separated by whitespace:
+//
+//	seconds minutes hours day-of-month month day-of-week [year]
+//
+// Exactly one of day-of-month and day-of-week must be "?".
+package quartz
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	MAX_YEAR = 2099
+	MIN_YEAR = 1970
+)
+
+var (
+	monthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	// Quartz numbers days of the week from 1 (Sunday) to 7 (Saturday)
+	dayOfWeekNames = map[string]int{
+		"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7,
+	}
+)
+
+// SyntaxError describes why an expression is invalid, and in which field.
+type SyntaxError struct {
+	Field   string
+	Message string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return fmt.Sprintf("%s field: %s", e.Field, e.Message)
+}
+
+// Expression is a parsed Quartz cron expression.
+type Expression struct {
+	seconds []bool
+	minutes []bool
+	hours   []bool
+	months  []bool
+	years   []bool
+
+	// Day-of-month - used when day-of-week is "?"
+	daysOfMonth       []bool
+	lastDayOfMonth    bool
+	lastDayOffset     int
+	nearestWeekday    bool
+	nearestWeekdayDay int
+
+	// Day-of-week - used when day-of-month is "?"
+	dayOfWeekSpecified bool
+	daysOfWeek         []bool
+	lastDayOfWeek      bool
+	nthDayOfWeek       int
+}
+
+// Parse parses a Quartz cron expression.
+func Parse(expression string) (*Expression, error) {
+	fields := strings.Fields(strings.ToUpper(expression))
+	if len(fields) < 6 || len(fields) > 7 {
+		return nil, &SyntaxError{Message: fmt.Sprintf("expected 6 or 7 fields (seconds minutes hours day-of-month month day-of-week [year]) but found %d", len(fields))}
+	}
+
+	e := &Expression{}
+	var err error
+	if e.seconds, err = parseField("seconds", fields[0], 0, 59, nil); err != nil {
+		return nil, err
+	}
+	if e.minutes, err = parseField("minutes", fields[1], 0, 59, nil); err != nil {
+		return nil, err
+	}
+	if e.hours, err = parseField("hours", fields[2], 0, 23, nil); err != nil {
+		return nil, err
+	}
+	if e.months, err = parseField("month", fields[4], 1, 12, monthNames); err != nil {
+		return nil, err
+	}
+	year := "*"
+	if len(fields) == 7 {
+		year = fields[6]
+	}
+	if e.years, err = parseField("year", year, MIN_YEAR, MAX_YEAR, nil); err != nil {
+		return nil, err
+	}
+
+	dayOfMonth, dayOfWeek := fields[3], fields[5]
+	switch {
+	case dayOfMonth == "?" && dayOfWeek == "?":
+		return nil, &SyntaxError{Field: "day-of-week", Message: "'?' can only be used for one of day-of-month and day-of-week"}
+	case dayOfMonth != "?" && dayOfWeek != "?":
+		return nil, &SyntaxError{Field: "day-of-week", Message: "one of day-of-month and day-of-week must be '?' - specifying both is not supported"}
+	case dayOfWeek == "?":
+		err = e.parseDayOfMonth(dayOfMonth)
+	default:
+		e.dayOfWeekSpecified = true
+		err = e.parseDayOfWeek(dayOfWeek)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// Validate returns an error describing why the expression is not a valid Quartz cron expression, if it
+// is not.
+func Validate(expression string) error {
+	_, err := Parse(expression)
+	return err
+}
+
+func (e *Expression) parseDayOfMonth(text string) error {
+	const field = "day-of-month"
+	switch {
+	case text == "L":
+		e.lastDayOfMonth = true
+		return nil
+	case text == "LW":
+		e.lastDayOfMonth = true
+		e.nearestWeekday = true
+		return nil
+	case strings.HasPrefix(text, "L-"):
+		offset, err := strconv.Atoi(strings.TrimPrefix(text, "L-"))
+		if err != nil || offset < 0 || offset > 30 {
+			return &SyntaxError{Field: field, Message: fmt.Sprintf("'%s' - offset from the last day must be between 0 and 30", text)}
+		}
+		e.lastDayOfMonth = true
+		e.lastDayOffset = offset
+		return nil
+	case strings.HasSuffix(text, "W"):
+		day, err := strconv.Atoi(strings.TrimSuffix(text, "W"))
+		if err != nil || day < 1 || day > 31 {
+			return &SyntaxError{Field: field, Message: fmt.Sprintf("'%s' - 'W' must follow a single day between 1 and 31", text)}
+		}
+		e.nearestWeekday = true
+		e.nearestWeekdayDay = day
+		return nil
+	}
+
+	days, err := parseField(field, text, 1, 31, nil)
+	if err != nil {
+		return err
+	}
+	e.daysOfMonth = days
+	return nil
+}
+
+func (e *Expression) parseDayOfWeek(text string) error {
+	const field = "day-of-week"
+	switch {
+	case text == "L":
+		e.daysOfWeek = make([]bool, 8)
+		e.daysOfWeek[7] = true
+		return nil
+	case strings.HasSuffix(text, "L"):
+		day, err := parseValue(strings.TrimSuffix(text, "L"), 1, 7, dayOfWeekNames)
+		if err != nil {
+			return &SyntaxError{Field: field, Message: fmt.Sprintf("'%s' - 'L' must follow a single day of the week: %s", text, err)}
+		}
+		e.daysOfWeek = make([]bool, 8)
+		e.daysOfWeek[day] = true
+		e.lastDayOfWeek = true
+		return nil
+	case strings.Contains(text, "#"):
+		parts := strings.SplitN(text, "#", 2)
+		day, err := parseValue(parts[0], 1, 7, dayOfWeekNames)
+		if err != nil {
+			return &SyntaxError{Field: field, Message: fmt.Sprintf("'%s' - '#' must follow a single day of the week: %s", text, err)}
+		}
+		nth, err := strconv.Atoi(parts[1])
+		if err != nil || nth < 1 || nth > 5 {
+			return &SyntaxError{Field: field, Message: fmt.Sprintf("'%s' - '#' must be followed by a number between 1 and 5", text)}
+		}
+		e.daysOfWeek = make([]bool, 8)
+		e.daysOfWeek[day] = true
+		e.nthDayOfWeek = nth
+		return nil
+	}
+
+	days, err := parseField(field, text, 1, 7, dayOfWeekNames)
+	if err != nil {
+		return err
+	}
+	e.daysOfWeek = days
+	return nil
+}
+
+// parseField parses a comma separated list of values, ranges ("a-b") and increments ("a/n", "*/n" or
+// "a-b/n"), returning the allowed values indexed from zero.
+func parseField(field, text string, min, max int, names map[string]int) ([]bool, error) {
+	values := make([]bool, max+1)
+	for _, part := range strings.Split(text, ",") {
+		if part == "" {
+			return nil, &SyntaxError{Field: field, Message: fmt.Sprintf("'%s' contains an empty value", text)}
+		}
+
+		rangeText, step := part, 1
+		if before, after, found := strings.Cut(part, "/"); found {
+			var err error
+			step, err = strconv.Atoi(after)
+			if err != nil || step < 1 || step > max-min+1 {
+				return nil, &SyntaxError{Field: field, Message: fmt.Sprintf("'%s' - increment must be a number between 1 and %d", part, max-min+1)}
+			}
+			rangeText = before
+			if rangeText == "" {
+				rangeText = strconv.Itoa(min)
+			}
+		}
+
+		var start, end int
+		switch {
+		case rangeText == "*":
+			start, end = min, max
+		case strings.Contains(rangeText, "-"):
+			from, to, _ := strings.Cut(rangeText, "-")
+			var err error
+			if start, err = parseValue(from, min, max, names); err != nil {
+				return nil, &SyntaxError{Field: field, Message: err.Error()}
+			}
+			if end, err = parseValue(to, min, max, names); err != nil {
+				return nil, &SyntaxError{Field: field, Message: err.Error()}
+			}
+		default:
+			var err error
+			if start, err = parseValue(rangeText, min, max, names); err != nil {
+				return nil, &SyntaxError{Field: field, Message: err.Error()}
+			}
+			end = start
+			if step > 1 || strings.Contains(part, "/") {
+				end = max
+			}
+		}
+
+		// Ranges such as "FRI-MON" or "22-2" wrap around
+		for i, v := 0, start; ; i++ {
+			if i%step == 0 {
+				values[v] = true
+			}
+			if v == end {
+				break
+			}
+			v++
+			if v > max {
+				v = min
+			}
+		}
+	}
+	return values, nil
+}
+
+// parseValue parses a single number, or name, checking it is between min and max.
+func parseValue(text string, min, max int, names map[string]int) (int, error) {
+	if v, ok := names[text]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(text)
+	if err != nil {
+		return 0, fmt.Errorf("'%s' is not a valid value", text)
+	}
+	if v < min || v > max {
+		return 0, fmt.Errorf("%d is outside the allowed range %d-%d", v, min, max)
+	}
+	return v, nil
+}
+
+// Next returns the first time after the given time that the expression matches, in the location of the
+// given time - or the zero time if the expression never matches again.
+func (e *Expression) Next(after time.Time) time.Time {
+	loc := after.Location()
+	day := time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, loc)
+	for day.Year() <= MAX_YEAR {
+		if e.matchesDay(day) {
+			if t, ok := e.nextTimeOfDay(day, after); ok {
+				return t
+			}
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+	return time.Time{}
+}
+
+// nextTimeOfDay returns the first time on the given day, after the given time, that matches.
+func (e *Expression) nextTimeOfDay(day time.Time, after time.Time) (time.Time, bool) {
+	for h := range e.hours {
+		if !e.hours[h] {
+			continue
+		}
+		for m := range e.minutes {
+			if !e.minutes[m] {
+				continue
+			}
+			for s := range e.seconds {
+				if !e.seconds[s] {
+					continue
+				}
+				t := time.Date(day.Year(), day.Month(), day.Day(), h, m, s, 0, day.Location())
+				if t.After(after) {
+					return t, true
+				}
+			}
+		}
+	}
+	return time.Time{}, false
+}
+
+func (e *Expression) matchesDay(day time.Time) bool {
+	if day.Year() < MIN_YEAR || !e.years[day.Year()] || !e.months[day.Month()] {
+		return false
+	}
+
+	lastDay := daysIn(day.Year(), day.Month())
+	if e.dayOfWeekSpecified {
+		dayOfWeek := int(day.Weekday()) + 1
+		if !e.daysOfWeek[dayOfWeek] {
+			return false
+		}
+		switch {
+		case e.lastDayOfWeek:
+			return day.Day()+7 > lastDay
+		case e.nthDayOfWeek > 0:
+			return (day.Day()-1)/7+1 == e.nthDayOfWeek
+		}
+		return true
+	}
+
+	switch {
+	case e.lastDayOfMonth && e.nearestWeekday:
+		return day.Day() == nearestWeekday(day.Year(), day.Month(), lastDay)
+	case e.lastDayOfMonth:
+		return day.Day() == lastDay-e.lastDayOffset
+	case e.nearestWeekday:
+		if e.nearestWeekdayDay > lastDay {
+			return false
+		}
+		return day.Day() == nearestWeekday(day.Year(), day.Month(), e.nearestWeekdayDay)
+	}
+	return e.daysOfMonth[day.Day()]
+}
+
+// nearestWeekday returns the weekday (Monday to Friday) nearest the given day, without leaving its month.
+func nearestWeekday(year int, month time.Month, day int) int {
+	lastDay := daysIn(year, month)
+	switch time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday() {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == lastDay {
+			return day - 2
+		}
+		return day + 1
+	}
+	return day
+}
+
+// daysIn returns the number of days in the given month.
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,164 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package quartz_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"terraform-provider-sonatyperepo/internal/provider/quartz"
+)
+
+func TestValidateValidExpressions(t *testing.T) {
+	expressions := []string{
+		"0 0 1 * * ?",
+		"0 0/15 * * * ?",
+		"0 */5 9-17 ? * MON-FRI",
+		"0 30 2 ? * SUN,WED",
+		"0 0 12 1,15 * ?",
+		"0 0 0 L * ?",
+		"0 0 0 L-2 * ?",
+		"0 0 0 LW * ?",
+		"0 0 0 15W * ?",
+		"0 0 0 ? * 6L",
+		"0 0 0 ? * FRI#3",
+		"0 0 0 ? JAN-MAR,DEC 2",
+		"0 0 22-2 * * ?",
+		"0 0 0 1 1 ? 2027",
+		"  0 0 1 * * ?  ",
+	}
+
+	for _, expression := range expressions {
+		t.Run(expression, func(t *testing.T) {
+			assert.NoError(t, quartz.Validate(expression))
+		})
+	}
+}
+
+func TestValidateInvalidExpressions(t *testing.T) {
+	testCases := []struct {
+		expression    string
+		expectedError string
+	}{
+		{"", "expected 6 or 7 fields (seconds minutes hours day-of-month month day-of-week [year]) but found 0"},
+		{"0 0 1 * *", "expected 6 or 7 fields (seconds minutes hours day-of-month month day-of-week [year]) but found 5"},
+		{"0 0 1 * * ? ?", "year field: '?' is not a valid value"},
+		{"0 0 1 * * *", "day-of-week field: one of day-of-month and day-of-week must be '?' - specifying both is not supported"},
+		{"0 0 1 ? * ?", "day-of-week field: '?' can only be used for one of day-of-month and day-of-week"},
+		{"60 0 1 * * ?", "seconds field: 60 is outside the allowed range 0-59"},
+		{"0 0 24 * * ?", "hours field: 24 is outside the allowed range 0-23"},
+		{"0 0 1 0 * ?", "day-of-month field: 0 is outside the allowed range 1-31"},
+		{"0 0 1 * 13 ?", "month field: 13 is outside the allowed range 1-12"},
+		{"0 0 1 * FOO ?", "month field: 'FOO' is not a valid value"},
+		{"0 0 1 ? * 8", "day-of-week field: 8 is outside the allowed range 1-7"},
+		{"0 0/0 * * * ?", "minutes field: '0/0' - increment must be a number between 1 and 60"},
+		{"0 0 1,,2 * * ?", "hours field: '1,,2' contains an empty value"},
+		{"0 0 1 32W * ?", "day-of-month field: '32W' - 'W' must follow a single day between 1 and 31"},
+		{"0 0 1 ? * FRI#6", "day-of-week field: 'FRI#6' - '#' must be followed by a number between 1 and 5"},
+		{"0 0 1 * * ? 1969", "year field: 1969 is outside the allowed range 1970-2099"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			err := quartz.Validate(tc.expression)
+			if assert.Error(t, err) {
+				assert.Equal(t, tc.expectedError, err.Error())
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	// Sunday 18 October 2026, 10:30:00 UTC
+	after := time.Date(2026, 10, 18, 10, 30, 0, 0, time.UTC)
+
+	testCases := []struct {
+		expression string
+		expected   []time.Time
+	}{
+		{"0 0 1 * * ?", []time.Time{
+			time.Date(2026, 10, 19, 1, 0, 0, 0, time.UTC),
+			time.Date(2026, 10, 20, 1, 0, 0, 0, time.UTC),
+		}},
+		{"0 0/20 * * * ?", []time.Time{
+			time.Date(2026, 10, 18, 10, 40, 0, 0, time.UTC),
+			time.Date(2026, 10, 18, 11, 0, 0, 0, time.UTC),
+		}},
+		{"0 30 2 ? * MON,FRI", []time.Time{
+			time.Date(2026, 10, 19, 2, 30, 0, 0, time.UTC),
+			time.Date(2026, 10, 23, 2, 30, 0, 0, time.UTC),
+		}},
+		{"0 0 0 L * ?", []time.Time{
+			time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC),
+		}},
+		// 31 October 2026 is a Saturday, 31 January 2027 a Sunday
+		{"0 0 0 LW * ?", []time.Time{
+			time.Date(2026, 10, 30, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 11, 30, 0, 0, 0, 0, time.UTC),
+		}},
+		// 1 November 2026 is a Sunday
+		{"0 0 0 1W * ?", []time.Time{
+			time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 12, 1, 0, 0, 0, 0, time.UTC),
+		}},
+		{"0 0 0 ? * 6L", []time.Time{
+			time.Date(2026, 10, 30, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 11, 27, 0, 0, 0, 0, time.UTC),
+		}},
+		{"0 0 0 ? * MON#2", []time.Time{
+			time.Date(2026, 11, 9, 0, 0, 0, 0, time.UTC),
+			time.Date(2026, 12, 14, 0, 0, 0, 0, time.UTC),
+		}},
+		{"0 0 12 29 2 ?", []time.Time{
+			time.Date(2028, 2, 29, 12, 0, 0, 0, time.UTC),
+			time.Date(2032, 2, 29, 12, 0, 0, 0, time.UTC),
+		}},
+		{"0 0 0 1 1 ? 2027", []time.Time{
+			time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC),
+			{},
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.expression, func(t *testing.T) {
+			expression, err := quartz.Parse(tc.expression)
+			if !assert.NoError(t, err) {
+				return
+			}
+			next := after
+			for _, expected := range tc.expected {
+				next = expression.Next(next)
+				assert.Equal(t, expected, next)
+			}
+		})
+	}
+}
+
+func TestNextUsesLocationOfTime(t *testing.T) {
+	expression, err := quartz.Parse("0 0 1 * * ?")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	offset := time.FixedZone("", -5*60*60)
+	next := expression.Next(time.Date(2026, 10, 18, 10, 30, 0, 0, offset))
+
+	assert.Equal(t, time.Date(2026, 10, 19, 6, 0, 0, 0, time.UTC), next.UTC())
+}
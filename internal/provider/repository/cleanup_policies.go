@@ -18,13 +18,15 @@ package repository
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"regexp"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -34,6 +36,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/dependency"
 	"terraform-provider-sonatyperepo/internal/provider/model"
 
 	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
@@ -42,7 +45,23 @@ import (
 	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
 )
 
-const cleanupPolicyNamePattern = `^[a-zA-Z0-9\-]{1}[a-zA-Z0-9_\-\.]*$`
+const (
+	cleanupPolicyNamePattern   = `^[a-zA-Z0-9\-]{1}[a-zA-Z0-9_\-\.]*$`
+	cleanupPolicyAllFormats    = "*"
+	cleanupPolicySortByVersion = "version"
+	cleanupPolicySortByDate    = "date"
+)
+
+var (
+	// cleanupPolicyReleaseTypeFormats are the formats that distinguish pre-release Components from releases
+	cleanupPolicyReleaseTypeFormats = []string{"maven2", "npm", "yum"}
+
+	// cleanupPolicyRetainFormats are the formats that support retaining the latest versions of each Component
+	cleanupPolicyRetainFormats = []string{"docker", "maven2", "npm"}
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ resource.ResourceWithModifyPlan = &cleanupPolicyResource{}
 
 // cleanupPolicyResource is the resource implementation.
 type cleanupPolicyResource struct {
@@ -87,22 +106,60 @@ func (r *cleanupPolicyResource) Schema(_ context.Context, _ resource.SchemaReque
 				map[string]tfschema.Attribute{
 					"last_blob_updated": schema.ResourceOptionalInt64("Remove components that haven't been downloaded in this many days"),
 					"last_downloaded":   schema.ResourceOptionalInt64("Remove components that were last downloaded more than this many days ago"),
-					"release_type":      schema.ResourceOptionalString("Remove components that match this release type (e.g., RELEASES, PRERELEASES)"),
-					"asset_regex":       schema.ResourceOptionalString("Remove components that have at least one asset name matching this regular expression"),
+					"release_type": schema.ResourceOptionalStringEnum(
+						"Remove components that match this release type - only supported for maven2, npm and yum",
+						"RELEASES", "PRERELEASES",
+					),
+					"asset_regex": schema.ResourceOptionalString("Remove components that have at least one asset name matching this regular expression"),
 				},
 			),
-			"retain":       schema.ResourceOptionalInt64("Minimum number of component versions to retain"),
+			"retain": schema.ResourceOptionalInt64(
+				"Exclusion criteria: number of the latest versions of each component to keep - requires Sonatype Nexus Repository Manager PRO, and only supported for docker, maven2 and npm",
+			),
+			"sort_by": func() tfschema.StringAttribute {
+				attr := schema.ResourceOptionalStringEnum(
+					"Exclusion criteria: how component versions are ordered when deciding which to retain - requires `retain`",
+					cleanupPolicySortByVersion, cleanupPolicySortByDate,
+				)
+				attr.Computed = true
+				attr.PlanModifiers = []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				}
+				return attr
+			}(),
+			"force_detach": schema.ResourceOptionalBoolWithDefault(
+				"When destroying, remove this cleanup policy from any Repositories it is applied to first - otherwise deletion fails while it is in use",
+				false,
+			),
 			"last_updated": schema.ResourceLastUpdated(),
 		},
 	}
 }
 
+// ModifyPlan reports criteria that are not supported for the format of the cleanup policy, or by the
+// connected Sonatype Nexus Repository, so they fail at plan time rather than when applied.
+func (r *cleanupPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when destroying, or before the Provider has been configured
+	if req.Plan.Raw.IsNull() || !r.IsConfigured() {
+		return
+	}
+
+	// Validate what is configured - sort_by may otherwise be carried over from state
+	var config model.CleanupPolicyModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		tflog.Debug(ctx, "Skipping cleanup policy plan validation as configuration could not be read")
+		return
+	}
+
+	resp.Diagnostics.Append(validateCriteriaForFormat(config, r.NxrmVersion)...)
+}
+
 // Create creates the resource and sets the initial Terraform state.
 func (r *cleanupPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var plan model.CleanupPolicyModel
 
-	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
@@ -118,6 +175,11 @@ func (r *cleanupPolicyResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	// sort_by is computed - leave it to the next refresh if not configured
+	if plan.SortBy.IsUnknown() {
+		plan.SortBy = types.StringNull()
+	}
+
 	// Call API to Create
 	ctx = r.AuthContext(ctx)
 
@@ -169,10 +231,8 @@ func (r *cleanupPolicyResource) Read(ctx context.Context, req resource.ReadReque
 	ctx = r.AuthContext(ctx)
 
 	// Fetch cleanup policy from API
-	cleanupPolicy, err := r.fetchCleanupPolicy(ctx, state.Name.ValueString())
+	cleanupPolicy, httpResponse, err := r.Services.CleanupPolicy.Get(ctx, state.Name.ValueString())
 	if err != nil {
-		// Check if this is a 404 error by attempting to get the HTTP response
-		httpResponse, _ := r.Services.CleanupPolicy.GetByName(ctx, state.Name.ValueString())
 		if httpResponse != nil && httpResponse.StatusCode == 404 {
 			resp.State.RemoveResource(ctx)
 			errors.HandleAPIWarning(
@@ -223,6 +283,11 @@ func (r *cleanupPolicyResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	// sort_by is computed - leave it to the next refresh if not configured
+	if plan.SortBy.IsUnknown() {
+		plan.SortBy = types.StringNull()
+	}
+
 	ctx = r.AuthContext(ctx)
 
 	// Build request payload and make API call
@@ -254,6 +319,10 @@ func (r *cleanupPolicyResource) Delete(ctx context.Context, req resource.DeleteR
 
 	ctx = r.AuthContext(ctx)
 
+	if !dependency.GuardDelete(ctx, r.Services, dependency.KIND_CLEANUP_POLICY, state.Name.ValueString(), state.ForceDetach.ValueBool(), &resp.Diagnostics) {
+		return
+	}
+
 	// Delete API Call
 	apiResponse, err := r.Services.CleanupPolicy.DeleteByName(ctx, state.Name.ValueString())
 
@@ -293,6 +362,7 @@ func (r *cleanupPolicyResource) Delete(ctx context.Context, req resource.DeleteR
 func (r *cleanupPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_detach"), false)...)
 }
 
 // Helper functions
@@ -314,6 +384,63 @@ func validateCriteria(criteria *model.CleanupPolicyCriteriaModel) error {
 	return nil
 }
 
+// validateCriteriaForFormat reports criteria that are not supported for the format of the cleanup
+// policy, or by the given version of Sonatype Nexus Repository.
+func validateCriteriaForFormat(config model.CleanupPolicyModel, version common.SystemVersion) diag.Diagnostics {
+	diags := diag.Diagnostics{}
+	if config.Format.IsUnknown() || config.Criteria == nil {
+		return diags
+	}
+	format := config.Format.ValueString()
+
+	if format == cleanupPolicyAllFormats && isConfigured(config.Criteria.AssetRegex) {
+		diags.AddAttributeError(
+			path.Root("criteria").AtName("asset_regex"),
+			"Criterion not supported for all formats",
+			"asset_regex can only be used by a cleanup policy for a single format - use last_blob_updated or last_downloaded",
+		)
+	}
+
+	if isConfigured(config.Criteria.ReleaseType) && !slices.Contains(cleanupPolicyReleaseTypeFormats, format) {
+		diags.AddAttributeError(
+			path.Root("criteria").AtName("release_type"),
+			"Criterion not supported for format",
+			fmt.Sprintf("release_type is not supported for format '%s' - only for %s", format, strings.Join(cleanupPolicyReleaseTypeFormats, ", ")),
+		)
+	}
+
+	if isConfigured(config.Retain) {
+		if !version.SupportsCleanupPolicyRetain() {
+			diags.AddAttributeError(
+				path.Root("retain"),
+				"Exclusion criteria not supported",
+				fmt.Sprintf("retain requires Sonatype Nexus Repository Manager 3.58.0 PRO or later - connected to %s", version.String()),
+			)
+		} else if !slices.Contains(cleanupPolicyRetainFormats, format) {
+			diags.AddAttributeError(
+				path.Root("retain"),
+				"Exclusion criteria not supported for format",
+				fmt.Sprintf("retain is not supported for format '%s' - only for %s", format, strings.Join(cleanupPolicyRetainFormats, ", ")),
+			)
+		}
+	}
+
+	if isConfigured(config.SortBy) && config.Retain.IsNull() {
+		diags.AddAttributeError(
+			path.Root("sort_by"),
+			"Invalid exclusion criteria",
+			"sort_by can only be set together with retain",
+		)
+	}
+
+	return diags
+}
+
+// isConfigured is true when a value has been set in configuration and is already known.
+func isConfigured(v attr.Value) bool {
+	return !v.IsNull() && !v.IsUnknown()
+}
+
 // buildRequestPayload creates the API request payload from the model
 func buildRequestPayload(plan model.CleanupPolicyModel) sonatyperepo.CleanupPolicyResourceXO {
 	requestPayload := sonatyperepo.CleanupPolicyResourceXO{
@@ -331,6 +458,10 @@ func buildRequestPayload(plan model.CleanupPolicyModel) sonatyperepo.CleanupPoli
 	if !plan.Retain.IsNull() {
 		retain := int32(plan.Retain.ValueInt64())
 		requestPayload.Retain = &retain
+
+		if !plan.SortBy.IsNull() && !plan.SortBy.IsUnknown() {
+			requestPayload.SortBy = plan.SortBy.ValueStringPointer()
+		}
 	}
 
 	return requestPayload
@@ -382,6 +513,8 @@ func updateStateFromAPI(state *model.CleanupPolicyModel, cleanupPolicy sonatyper
 	} else {
 		state.Retain = types.Int64Null()
 	}
+
+	state.SortBy = types.StringPointerValue(cleanupPolicy.SortBy)
 }
 
 // updateCriteriaFromAPI updates the criteria model from the API response
@@ -411,27 +544,6 @@ func updateCriteriaFromAPI(criteria *model.CleanupPolicyCriteriaModel, cleanupPo
 	}
 }
 
-// fetchCleanupPolicy retrieves and parses the cleanup policy from the API
-func (r *cleanupPolicyResource) fetchCleanupPolicy(ctx context.Context, name string) (*sonatyperepo.CleanupPolicyResourceXO, error) {
-	httpResponse, err := r.Services.CleanupPolicy.GetByName(ctx, name)
-	if err != nil {
-		return nil, err
-	}
-
-	responseBody, err := io.ReadAll(httpResponse.Body)
-	if err != nil {
-		return nil, fmt.Errorf("could not read response body: %w", err)
-	}
-
-	var cleanupPolicy sonatyperepo.CleanupPolicyResourceXO
-	err = json.Unmarshal(responseBody, &cleanupPolicy)
-	if err != nil {
-		return nil, fmt.Errorf("could not parse response: %w", err)
-	}
-
-	return &cleanupPolicy, nil
-}
-
 // handleUpdateError handles errors from the update API call
 func (r *cleanupPolicyResource) handleUpdateError(resp *resource.UpdateResponse, apiResponse *http.Response, err error) {
 	if apiResponse != nil && apiResponse.StatusCode == 404 {
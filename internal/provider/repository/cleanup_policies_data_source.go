@@ -0,0 +1,111 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &cleanupPoliciesDataSource{}
+	_ datasource.DataSourceWithConfigure = &cleanupPoliciesDataSource{}
+)
+
+// CleanupPoliciesDataSource is a helper function to simplify the provider implementation.
+func CleanupPoliciesDataSource() datasource.DataSource {
+	return &cleanupPoliciesDataSource{}
+}
+
+// cleanupPoliciesDataSource is the data source implementation.
+type cleanupPoliciesDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *cleanupPoliciesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cleanup_policies"
+}
+
+// Schema defines the schema for the data source.
+func (d *cleanupPoliciesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get all cleanup policies, optionally only those for a single format",
+		Attributes: map[string]tfschema.Attribute{
+			"format": schema.DataSourceOptionalString(
+				"Only return cleanup policies for this Repository format - use '*' for cleanup policies that apply to all formats",
+			),
+			"cleanup_policies": schema.DataSourceComputedListNestedAttribute(
+				"List of Cleanup Policies",
+				tfschema.NestedAttributeObject{
+					Attributes: cleanupPolicyDataSourceAttributes(),
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *cleanupPoliciesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state model.CleanupPoliciesModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	ctx = d.AuthContext(ctx)
+
+	cleanupPolicies, httpResponse, err := d.Services.CleanupPolicy.List(ctx)
+	if err != nil {
+		errors.HandleAPIError(
+			"Unable to list cleanup policies",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Iterating %d cleanup policies", len(cleanupPolicies)))
+
+	state.CleanupPolicies = make([]model.CleanupPolicyModelDS, 0)
+	for _, cleanupPolicy := range cleanupPolicies {
+		if !state.Format.IsNull() && cleanupPolicy.Format != state.Format.ValueString() {
+			continue
+		}
+		state.CleanupPolicies = append(state.CleanupPolicies, cleanupPolicyModelDSFromApi(cleanupPolicy))
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,94 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	utils_test "terraform-provider-sonatyperepo/internal/provider/utils"
+)
+
+func TestAccCleanupPoliciesDataSource(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	allDataSourceName := "data.sonatyperepo_cleanup_policies.all"
+	npmDataSourceName := "data.sonatyperepo_cleanup_policies.npm"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCleanupPoliciesDataSourceConfig(randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs(allDataSourceName, "cleanup_policies.*", map[string]string{
+						"name":   fmt.Sprintf("test-cleanup-policies-maven-%s", randomString),
+						"format": "maven2",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(allDataSourceName, "cleanup_policies.*", map[string]string{
+						"name":   fmt.Sprintf("test-cleanup-policies-npm-%s", randomString),
+						"format": "npm",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(npmDataSourceName, "cleanup_policies.*", map[string]string{
+						"name":                     fmt.Sprintf("test-cleanup-policies-npm-%s", randomString),
+						"format":                   "npm",
+						"criteria.last_downloaded": "90",
+					}),
+					resource.TestCheckResourceAttr(npmDataSourceName, "format", "npm"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCleanupPoliciesDataSourceConfig(randomString string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatyperepo_cleanup_policy" "maven" {
+  name   = "test-cleanup-policies-maven-%s"
+  format = "maven2"
+  criteria = {
+    last_blob_updated = 30
+  }
+}
+
+resource "sonatyperepo_cleanup_policy" "npm" {
+  name   = "test-cleanup-policies-npm-%s"
+  format = "npm"
+  criteria = {
+    last_downloaded = 90
+  }
+}
+
+data "sonatyperepo_cleanup_policies" "all" {
+  depends_on = [
+    sonatyperepo_cleanup_policy.maven,
+    sonatyperepo_cleanup_policy.npm,
+  ]
+}
+
+data "sonatyperepo_cleanup_policies" "npm" {
+  format = "npm"
+
+  depends_on = [
+    sonatyperepo_cleanup_policy.maven,
+    sonatyperepo_cleanup_policy.npm,
+  ]
+}
+`, randomString, randomString)
+}
@@ -0,0 +1,125 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+)
+
+func TestValidateCriteriaForFormat(t *testing.T) {
+	pro := common.SystemVersion{Major: 3, Minor: 80, ProVersion: true}
+	oss := common.SystemVersion{Major: 3, Minor: 80}
+
+	testCases := []struct {
+		name         string
+		format       string
+		criteria     model.CleanupPolicyCriteriaModel
+		retain       types.Int64
+		sortBy       types.String
+		version      common.SystemVersion
+		expectErrors []path.Path
+	}{
+		{
+			name:     "maven2 release type",
+			format:   "maven2",
+			criteria: model.CleanupPolicyCriteriaModel{LastDownloaded: types.Int64Value(30), ReleaseType: types.StringValue("PRERELEASES")},
+			version:  oss,
+		},
+		{
+			name:         "raw release type",
+			format:       "raw",
+			criteria:     model.CleanupPolicyCriteriaModel{LastDownloaded: types.Int64Value(30), ReleaseType: types.StringValue("RELEASES")},
+			version:      oss,
+			expectErrors: []path.Path{path.Root("criteria").AtName("release_type")},
+		},
+		{
+			name:         "all formats asset regex",
+			format:       "*",
+			criteria:     model.CleanupPolicyCriteriaModel{AssetRegex: types.StringValue(".*")},
+			version:      oss,
+			expectErrors: []path.Path{path.Root("criteria").AtName("asset_regex")},
+		},
+		{
+			name:     "docker retain with pro",
+			format:   "docker",
+			criteria: model.CleanupPolicyCriteriaModel{LastBlobUpdated: types.Int64Value(30)},
+			retain:   types.Int64Value(5),
+			sortBy:   types.StringValue(cleanupPolicySortByDate),
+			version:  pro,
+		},
+		{
+			name:         "retain without pro",
+			format:       "maven2",
+			criteria:     model.CleanupPolicyCriteriaModel{LastBlobUpdated: types.Int64Value(30)},
+			retain:       types.Int64Value(5),
+			version:      oss,
+			expectErrors: []path.Path{path.Root("retain")},
+		},
+		{
+			name:         "retain for unsupported format",
+			format:       "pypi",
+			criteria:     model.CleanupPolicyCriteriaModel{LastBlobUpdated: types.Int64Value(30)},
+			retain:       types.Int64Value(5),
+			version:      pro,
+			expectErrors: []path.Path{path.Root("retain")},
+		},
+		{
+			name:         "sort by without retain",
+			format:       "npm",
+			criteria:     model.CleanupPolicyCriteriaModel{LastBlobUpdated: types.Int64Value(30)},
+			sortBy:       types.StringValue(cleanupPolicySortByVersion),
+			version:      pro,
+			expectErrors: []path.Path{path.Root("sort_by")},
+		},
+		{
+			name:     "unknown release type",
+			format:   "raw",
+			criteria: model.CleanupPolicyCriteriaModel{LastDownloaded: types.Int64Value(30), ReleaseType: types.StringUnknown()},
+			version:  oss,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			criteria := tc.criteria
+			config := model.CleanupPolicyModel{
+				Name:     types.StringValue("test"),
+				Format:   types.StringValue(tc.format),
+				Criteria: &criteria,
+				Retain:   tc.retain,
+				SortBy:   tc.sortBy,
+			}
+
+			diags := validateCriteriaForFormat(config, tc.version)
+
+			paths := make([]path.Path, 0)
+			for _, d := range diags.Errors() {
+				if withPath, ok := d.(interface{ Path() path.Path }); ok {
+					paths = append(paths, withPath.Path())
+				}
+			}
+			assert.ElementsMatch(t, tc.expectErrors, paths)
+		})
+	}
+}
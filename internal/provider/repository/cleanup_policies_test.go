@@ -82,13 +82,14 @@ func TestAccCleanupPolicyResourceMinimal(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Create and Read testing with minimal configuration
+			// Create and Read testing with minimal configuration - force_detach is not set, so its default is planned
 			{
 				Config: getTestAccCleanupPolicyResourceMinimalConfig(randomString),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("minimal-cleanup-policy-%s", randomString)),
 					resource.TestCheckResourceAttr(resourceName, "format", "maven2"),
 					resource.TestCheckResourceAttr(resourceName, criteriaLastBlobUpdated, "30"),
+					resource.TestCheckResourceAttr(resourceName, "force_detach", "false"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -111,6 +112,22 @@ func TestAccCleanupPolicyResourceInvalidFormat(t *testing.T) {
 	})
 }
 
+func TestAccCleanupPolicyResourceUnsupportedCriteriaForFormat(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// release_type is not supported for raw - rejected at plan time
+			{
+				Config:      getTestAccCleanupPolicyResourceRawReleaseTypeConfig(randomString),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("Criterion not supported for format"),
+			},
+		},
+	})
+}
+
 func getTestAccCleanupPolicyResourceConfig(randomString string) string {
 	return fmt.Sprintf(utils_test.ProviderConfig+`
 resource "sonatyperepo_cleanup_policy" "test" {
@@ -164,3 +181,17 @@ resource "sonatyperepo_cleanup_policy" "invalid" {
 }
 `, randomString)
 }
+
+func getTestAccCleanupPolicyResourceRawReleaseTypeConfig(randomString string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatyperepo_cleanup_policy" "raw" {
+  name   = "raw-cleanup-policy-%s"
+  format = "raw"
+
+  criteria = {
+    last_downloaded = 30
+    release_type    = "RELEASES"
+  }
+}
+`, randomString)
+}
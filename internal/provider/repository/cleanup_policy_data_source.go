@@ -0,0 +1,147 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+
+	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &cleanupPolicyDataSource{}
+	_ datasource.DataSourceWithConfigure = &cleanupPolicyDataSource{}
+)
+
+// CleanupPolicyDataSource is a helper function to simplify the provider implementation.
+func CleanupPolicyDataSource() datasource.DataSource {
+	return &cleanupPolicyDataSource{}
+}
+
+// cleanupPolicyDataSource is the data source implementation.
+type cleanupPolicyDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *cleanupPolicyDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cleanup_policy"
+}
+
+// Schema defines the schema for the data source.
+func (d *cleanupPolicyDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := cleanupPolicyDataSourceAttributes()
+	attributes["name"] = schema.DataSourceRequiredStringWithLengthAtLeast("Name of the cleanup policy", 1)
+
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get a single cleanup policy by name",
+		Attributes:  attributes,
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *cleanupPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data model.CleanupPolicyModelDS
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	ctx = d.AuthContext(ctx)
+
+	cleanupPolicy, httpResponse, err := d.Services.CleanupPolicy.Get(ctx, data.Name.ValueString())
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			errors.HandleAPIError(
+				"No cleanup policy with supplied name",
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+		} else {
+			errors.HandleAPIError(
+				"Error finding cleanup policy",
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+		}
+		return
+	}
+
+	state := cleanupPolicyModelDSFromApi(*cleanupPolicy)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// cleanupPolicyDataSourceAttributes are the computed attributes describing a single cleanup policy.
+func cleanupPolicyDataSourceAttributes() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{
+		"name":   schema.DataSourceComputedString("Name of the cleanup policy"),
+		"notes":  schema.DataSourceComputedString("Notes for the cleanup policy"),
+		"format": schema.DataSourceComputedString("Repository format that this cleanup policy applies to - '*' for all formats"),
+		"criteria": schema.DataSourceComputedSingleNestedAttribute(
+			"Cleanup criteria for this policy",
+			map[string]tfschema.Attribute{
+				"last_blob_updated": schema.DataSourceComputedInt64("Remove components that haven't been downloaded in this many days"),
+				"last_downloaded":   schema.DataSourceComputedInt64("Remove components that were last downloaded more than this many days ago"),
+				"release_type":      schema.DataSourceComputedString("Remove components that match this release type (e.g., RELEASES, PRERELEASES)"),
+				"asset_regex":       schema.DataSourceComputedString("Remove components that have at least one asset name matching this regular expression"),
+			},
+		),
+		"retain":  schema.DataSourceComputedInt64("Exclusion criteria: number of the latest versions of each component to keep"),
+		"sort_by": schema.DataSourceComputedString("Exclusion criteria: how component versions are ordered when deciding which to retain (version or date)"),
+	}
+}
+
+// cleanupPolicyModelDSFromApi maps a cleanup policy API response to the data source model
+func cleanupPolicyModelDSFromApi(cleanupPolicy sonatyperepo.CleanupPolicyResourceXO) model.CleanupPolicyModelDS {
+	m := model.CleanupPolicyModelDS{
+		Name:     types.StringValue(cleanupPolicy.Name),
+		Notes:    types.StringPointerValue(cleanupPolicy.Notes),
+		Format:   types.StringValue(cleanupPolicy.Format),
+		Criteria: &model.CleanupPolicyCriteriaModel{},
+		Retain:   types.Int64Null(),
+		SortBy:   types.StringPointerValue(cleanupPolicy.SortBy),
+	}
+	updateCriteriaFromAPI(m.Criteria, cleanupPolicy)
+	if cleanupPolicy.Retain != nil {
+		m.Retain = types.Int64Value(int64(*cleanupPolicy.Retain))
+	}
+	return m
+}
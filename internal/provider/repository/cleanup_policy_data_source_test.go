@@ -0,0 +1,84 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	utils_test "terraform-provider-sonatyperepo/internal/provider/utils"
+)
+
+func TestAccCleanupPolicyDataSource(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	cleanupPolicyName := fmt.Sprintf("test-cleanup-policy-ds-%s", randomString)
+	dataSourceName := "data.sonatyperepo_cleanup_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Test 1: Missing required argument
+			{
+				Config:      utils_test.ProviderConfig + `data "sonatyperepo_cleanup_policy" "test" {}`,
+				ExpectError: regexp.MustCompile("Error: Missing required argument"),
+			},
+			// Test 2: Create a cleanup policy and then read it with data source
+			{
+				Config: testAccCleanupPolicyDataSourceConfig(randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "name", cleanupPolicyName),
+					resource.TestCheckResourceAttr(dataSourceName, "format", "maven2"),
+					resource.TestCheckResourceAttr(dataSourceName, "notes", "Test cleanup policy for data source"),
+					resource.TestCheckResourceAttr(dataSourceName, criteriaLastBlobUpdated, "30"),
+					resource.TestCheckResourceAttr(dataSourceName, criteriaAssetRegex, ".*\\.jar$"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "criteria.last_downloaded"),
+				),
+			},
+			// Test 3: Cleanup policy that does not exist
+			{
+				Config: utils_test.ProviderConfig + fmt.Sprintf(`
+data "sonatyperepo_cleanup_policy" "missing" {
+  name = "missing-cleanup-policy-%s"
+}
+`, randomString),
+				ExpectError: regexp.MustCompile("No cleanup policy with supplied name"),
+			},
+		},
+	})
+}
+
+func testAccCleanupPolicyDataSourceConfig(randomString string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatyperepo_cleanup_policy" "test" {
+  name   = "test-cleanup-policy-ds-%s"
+  format = "maven2"
+  notes  = "Test cleanup policy for data source"
+  criteria = {
+    last_blob_updated = 30
+    asset_regex       = ".*\\.jar$"
+  }
+}
+
+data "sonatyperepo_cleanup_policy" "test" {
+  name = sonatyperepo_cleanup_policy.test.name
+}
+`, randomString)
+}
@@ -0,0 +1,167 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+
+	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+)
+
+const errCleanupPreviewContinuationTokenRepeated = "Cleanup preview returned continuation token '%s' more than once"
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &cleanupPolicyPreviewDataSource{}
+	_ datasource.DataSourceWithConfigure = &cleanupPolicyPreviewDataSource{}
+)
+
+// CleanupPolicyPreviewDataSource is a helper function to simplify the provider implementation.
+func CleanupPolicyPreviewDataSource() datasource.DataSource {
+	return &cleanupPolicyPreviewDataSource{}
+}
+
+// cleanupPolicyPreviewDataSource is the data source implementation.
+type cleanupPolicyPreviewDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *cleanupPolicyPreviewDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cleanup_policy_preview"
+}
+
+// Schema defines the schema for the data source.
+func (d *cleanupPolicyPreviewDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: `Use this data source to preview (dry-run) which Components a cleanup policy with the given criteria would delete from a Repository.
+
+Nothing is deleted - use this to review a cleanup policy before applying it to a Repository.`,
+		Attributes: map[string]tfschema.Attribute{
+			"repository_name": schema.DataSourceRequiredString("Name of the Repository to preview the cleanup policy against"),
+			"criteria": func() tfschema.SingleNestedAttribute {
+				attr := schema.DataSourceOptionalSingleNestedAttribute(
+					"Cleanup criteria to preview - at least one criterion must be specified",
+					map[string]tfschema.Attribute{
+						"last_blob_updated": schema.DataSourceOptionalInt64("Remove components that haven't been downloaded in this many days"),
+						"last_downloaded":   schema.DataSourceOptionalInt64("Remove components that were last downloaded more than this many days ago"),
+						"release_type":      schema.DataSourceOptionalString("Remove components that match this release type (e.g., RELEASES, PRERELEASES)"),
+						"asset_regex":       schema.DataSourceOptionalString("Remove components that have at least one asset name matching this regular expression"),
+					},
+				)
+				attr.Optional = false
+				attr.Required = true
+				return attr
+			}(),
+			"retain":          schema.DataSourceOptionalInt64("Minimum number of component versions to retain"),
+			"component_count": schema.DataSourceComputedInt64("Number of Components that would be deleted"),
+			"components": schema.DataSourceComputedListNestedAttribute(
+				"Components that would be deleted",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"group":   schema.DataSourceComputedString("Group of the Component"),
+						"name":    schema.DataSourceComputedString("Name of the Component"),
+						"version": schema.DataSourceComputedString("Version of the Component"),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *cleanupPolicyPreviewDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state model.CleanupPolicyPreviewModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	if err := validateCriteria(state.Criteria); err != nil {
+		resp.Diagnostics.AddError("Invalid cleanup policy preview configuration", err.Error())
+		return
+	}
+
+	ctx = d.AuthContext(ctx)
+
+	components, httpResponse, err := previewAllComponents(ctx, d.Services.CleanupPolicy, state.MapToPreview())
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			errors.HandleAPIError(
+				fmt.Sprintf("Repository '%s' does not exist", state.RepositoryName.ValueString()),
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+		} else {
+			errors.HandleAPIError(
+				"Unable to preview cleanup policy",
+				&err,
+				httpResponse,
+				&resp.Diagnostics,
+			)
+		}
+		return
+	}
+
+	state.MapFromApi(components)
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// previewAllComponents follows continuation tokens until every Component the cleanup policy would delete has been read
+func previewAllComponents(ctx context.Context, service common.CleanupPolicyService, preview common.CleanupPolicyPreview) ([]sonatyperepo.ComponentXO, *http.Response, error) {
+	components := make([]sonatyperepo.ComponentXO, 0)
+	seenTokens := make(map[string]bool)
+
+	var continuationToken *string
+	for {
+		page, httpResponse, err := service.Preview(ctx, preview, continuationToken)
+		if err != nil {
+			return nil, httpResponse, err
+		}
+		components = append(components, page.Items...)
+
+		if page.ContinuationToken == nil || *page.ContinuationToken == "" {
+			return components, httpResponse, nil
+		}
+		if seenTokens[*page.ContinuationToken] {
+			return nil, nil, fmt.Errorf(errCleanupPreviewContinuationTokenRepeated, *page.ContinuationToken)
+		}
+		seenTokens[*page.ContinuationToken] = true
+		continuationToken = page.ContinuationToken
+		tflog.Debug(ctx, fmt.Sprintf("Read %d Components, continuing cleanup preview", len(components)))
+	}
+}
@@ -0,0 +1,106 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+	"github.com/stretchr/testify/assert"
+)
+
+// pagedCleanupPolicyService serves preview pages keyed by the continuation token used to request them
+type pagedCleanupPolicyService struct {
+	common.CleanupPolicyService
+	pages         map[string]sonatyperepo.PageComponentXO
+	previewed     []common.CleanupPolicyPreview
+	requestedWith []string
+}
+
+func (s *pagedCleanupPolicyService) Preview(_ context.Context, preview common.CleanupPolicyPreview, continuationToken *string) (*sonatyperepo.PageComponentXO, *http.Response, error) {
+	token := ""
+	if continuationToken != nil {
+		token = *continuationToken
+	}
+	s.previewed = append(s.previewed, preview)
+	s.requestedWith = append(s.requestedWith, token)
+	page := s.pages[token]
+	return &page, &http.Response{StatusCode: http.StatusOK}, nil
+}
+
+func TestPreviewAllComponentsFollowsContinuationTokens(t *testing.T) {
+	service := &pagedCleanupPolicyService{
+		pages: map[string]sonatyperepo.PageComponentXO{
+			"": {
+				Items:             []sonatyperepo.ComponentXO{{Name: common.StringPointer("a")}, {Name: common.StringPointer("b")}},
+				ContinuationToken: common.StringPointer("page-2"),
+			},
+			"page-2": {
+				Items: []sonatyperepo.ComponentXO{{Name: common.StringPointer("c")}},
+			},
+		},
+	}
+	preview := common.CleanupPolicyPreview{RepositoryName: "maven-releases"}
+
+	components, _, err := previewAllComponents(context.Background(), service, preview)
+
+	assert.NoError(t, err)
+	assert.Len(t, components, 3)
+	assert.Equal(t, []string{"", "page-2"}, service.requestedWith)
+	assert.Equal(t, []common.CleanupPolicyPreview{preview, preview}, service.previewed)
+}
+
+func TestPreviewAllComponentsRepeatedContinuationToken(t *testing.T) {
+	service := &pagedCleanupPolicyService{
+		pages: map[string]sonatyperepo.PageComponentXO{
+			"":     {ContinuationToken: common.StringPointer("loop")},
+			"loop": {ContinuationToken: common.StringPointer("loop")},
+		},
+	}
+
+	_, _, err := previewAllComponents(context.Background(), service, common.CleanupPolicyPreview{})
+
+	assert.ErrorContains(t, err, "continuation token 'loop' more than once")
+}
+
+func TestCleanupPolicyPreviewModelMapToPreview(t *testing.T) {
+	m := model.CleanupPolicyPreviewModel{
+		RepositoryName: types.StringValue("npm-hosted"),
+		Criteria: &model.CleanupPolicyCriteriaModel{
+			LastBlobUpdated: types.Int64Null(),
+			LastDownloaded:  types.Int64Value(90),
+			ReleaseType:     types.StringNull(),
+			AssetRegex:      types.StringValue(".*\\.tgz$"),
+		},
+		Retain: types.Int64Value(3),
+	}
+
+	preview := m.MapToPreview()
+
+	assert.Equal(t, "npm-hosted", preview.RepositoryName)
+	assert.Nil(t, preview.LastBlobUpdated)
+	assert.Equal(t, int64(90), *preview.LastDownloaded)
+	assert.Nil(t, preview.ReleaseType)
+	assert.Equal(t, ".*\\.tgz$", *preview.AssetRegex)
+	assert.Equal(t, int32(3), *preview.Retain)
+}
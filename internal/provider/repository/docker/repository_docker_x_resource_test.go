@@ -269,3 +269,82 @@ func dockerRepositoryHostedPathTest(randomString string, pathEnabled bool) strin
 	}
 	}`, resourceTypeDockerHosted, randomString, pathEnabled)
 }
+
+func TestAccRepositoryDockerConnectorConflict(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	httpPort := acctest.RandIntRange(20000, 30000)
+	hostedConfig := fmt.Sprintf(`
+resource "%s" "repo" {
+  name = "docker-hosted-connector-%s"
+  online = true
+  storage = {
+	blob_store_name = "default"
+	latest_policy = true
+	strict_content_type_validation = true
+	write_policy = "ALLOW_ONCE"
+  }
+  docker = {
+    force_basic_auth = true
+    http_port = %d
+    subdomain = "docker-%s"
+    v1_enabled = false
+  }
+}
+`, resourceTypeDockerHosted, randomString, httpPort, randomString)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create first Docker Repository claiming a port and subdomain
+			{
+				Config: utils_test.ProviderConfig + hostedConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceDockerHostedName, "docker.http_port", fmt.Sprintf("%d", httpPort)),
+				),
+			},
+			// Second Docker Repository claiming the same port as HTTPS is rejected at plan time
+			{
+				Config: utils_test.ProviderConfig + hostedConfig + fmt.Sprintf(`
+resource "%s" "repo" {
+  name = "docker-group-connector-%s"
+  online = true
+  storage = {
+	blob_store_name = "default"
+	strict_content_type_validation = true
+  }
+  group = {
+	member_names = [%s.repo.name]
+  }
+  docker = {
+    force_basic_auth = true
+    https_port = %d
+    v1_enabled = false
+  }
+}
+`, resourceTypeDockerGroup, randomString, resourceTypeDockerHosted, httpPort),
+				ExpectError: regexp.MustCompile(fmt.Sprintf("Port %d is already used by the HTTP connector", httpPort)),
+			},
+			// Second Docker Repository claiming the same subdomain is rejected at plan time
+			{
+				Config: utils_test.ProviderConfig + hostedConfig + fmt.Sprintf(`
+resource "%s" "repo2" {
+  name = "docker-hosted-connector-2-%s"
+  online = true
+  storage = {
+	blob_store_name = "default"
+	latest_policy = true
+	strict_content_type_validation = true
+	write_policy = "ALLOW_ONCE"
+  }
+  docker = {
+    force_basic_auth = true
+    subdomain = "DOCKER-%s"
+    v1_enabled = false
+  }
+}
+`, resourceTypeDockerHosted, randomString, randomString),
+				ExpectError: regexp.MustCompile("is already used by Docker Repository"),
+			},
+		},
+	})
+}
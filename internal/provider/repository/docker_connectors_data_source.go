@@ -0,0 +1,113 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"terraform-provider-sonatyperepo/internal/provider/repository/format"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &dockerConnectorsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dockerConnectorsDataSource{}
+)
+
+// DockerConnectorsDataSource is a helper function to simplify the provider implementation.
+func DockerConnectorsDataSource() datasource.DataSource {
+	return &dockerConnectorsDataSource{}
+}
+
+// dockerConnectorsDataSource is the data source implementation.
+type dockerConnectorsDataSource struct {
+	common.BaseDataSource
+}
+
+// Metadata returns the data source type name.
+func (d *dockerConnectorsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_docker_connectors"
+}
+
+// Schema defines the schema for the data source.
+func (d *dockerConnectorsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: "Use this data source to get the connector ports and subdomains allocated to all Docker Repositories",
+		Attributes: map[string]tfschema.Attribute{
+			"connectors": schema.DataSourceComputedListNestedAttribute(
+				"List of Docker Repository connectors",
+				tfschema.NestedAttributeObject{
+					Attributes: map[string]tfschema.Attribute{
+						"repository_name": schema.DataSourceComputedString("Name of the Docker Repository"),
+						"repository_type": schema.DataSourceComputedString("Type of the Docker Repository"),
+						"http_port":       schema.DataSourceComputedInt32("Port of the HTTP connector, if any"),
+						"https_port":      schema.DataSourceComputedInt32("Port of the HTTPS connector, if any"),
+						"subdomain":       schema.DataSourceComputedString("Subdomain, if any"),
+					},
+				},
+			),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *dockerConnectorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state model.DockerConnectorsModel
+
+	ctx = d.AuthContext(ctx)
+
+	connectors, httpResponse, err := format.ListDockerConnectors(ctx, d.Services.Repository)
+	if err != nil {
+		errors.HandleAPIError(
+			"Unable to read Docker Repository connectors",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Iterating %d Docker Repositories", len(connectors)))
+
+	state.Connectors = make([]model.DockerConnectorModel, 0, len(connectors))
+	for _, connector := range connectors {
+		state.Connectors = append(state.Connectors, model.DockerConnectorModel{
+			RepositoryName: types.StringValue(connector.RepositoryName),
+			RepositoryType: types.StringValue(connector.RepositoryType),
+			HttpPort:       types.Int32PointerValue(connector.HttpPort),
+			HttpsPort:      types.Int32PointerValue(connector.HttpsPort),
+			Subdomain:      types.StringPointerValue(connector.Subdomain),
+		})
+	}
+
+	// Set state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,74 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	utils_test "terraform-provider-sonatyperepo/internal/provider/utils"
+)
+
+func TestAccDockerConnectorsDataSource(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	httpPort := acctest.RandIntRange(20000, 30000)
+	dataSourceName := "data.sonatyperepo_docker_connectors.connectors"
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatyperepo_repository_docker_hosted" "test_hosted" {
+  name   = "test-docker-hosted-ds-%s"
+  online = true
+  storage = {
+    blob_store_name                = "default"
+    latest_policy                  = true
+    strict_content_type_validation = true
+    write_policy                   = "ALLOW_ONCE"
+  }
+  docker = {
+    force_basic_auth = true
+    http_port        = %d
+    v1_enabled       = false
+  }
+}
+
+data "sonatyperepo_docker_connectors" "connectors" {
+  depends_on = [sonatyperepo_repository_docker_hosted.test_hosted]
+}
+`, randomString, httpPort),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "connectors.#"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						dataSourceName,
+						"connectors.*",
+						map[string]string{
+							"repository_name": fmt.Sprintf("test-docker-hosted-ds-%s", randomString),
+							"repository_type": "hosted",
+							"http_port":       fmt.Sprintf("%d", httpPort),
+						},
+					),
+				),
+			},
+		},
+	})
+}
@@ -126,6 +126,12 @@ func (f *AlpineRepositoryFormatHosted) UpdateStateFromApi(state any, api any) an
 	return stateModel
 }
 
+func (f *AlpineRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryAlpineHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
 func (f *AlpineRepositoryFormatHosted) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryAlpineHostedModel)
 	var stateModel model.RepositoryAlpineHostedModel
@@ -251,6 +257,23 @@ func (f *AlpineRepositoryFormatProxy) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *AlpineRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryAlpineProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *AlpineRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryAlpineProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *AlpineRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryAlpineProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *AlpineRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryAlpineProxyModel)
 	var stateModel model.RepositoryAlpineProxyModel
@@ -393,6 +416,17 @@ func (f *AlpineRepositoryFormatGroup) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *AlpineRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositoryAlpineGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, types.StringNull())
+}
+
+func (f *AlpineRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositoryAlpineGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for Alpine Group repositories
 func (f *AlpineRepositoryFormatGroup) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
@@ -122,6 +122,12 @@ func (f *AnsibleGalaxyRepositoryFormatHosted) UpdateStateFromApi(state, api any)
 	return stateModel
 }
 
+func (f *AnsibleGalaxyRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryAnsibleGalaxyHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for Ansible Galaxy Hosted repositories
 func (f *AnsibleGalaxyRepositoryFormatHosted) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
@@ -206,6 +212,23 @@ func (f *AnsibleGalaxyRepositoryFormatProxy) UpdateStateFromApi(state, api any)
 	return stateModel
 }
 
+func (f *AnsibleGalaxyRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryAnsibleGalaxyProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *AnsibleGalaxyRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryAnsibleGalaxyProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *AnsibleGalaxyRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryAnsibleGalaxyProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *AnsibleGalaxyRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryAnsibleGalaxyProxyModel)
 	var stateModel model.RepositoryAnsibleGalaxyProxyModel
@@ -287,6 +310,17 @@ func (f *AnsibleGalaxyRepositoryFormatGroup) UpdateStateFromApi(state, api any)
 	return stateModel
 }
 
+func (f *AnsibleGalaxyRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositoryAnsibleGalaxyGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, types.StringNull())
+}
+
+func (f *AnsibleGalaxyRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositoryAnsibleGalaxyGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for Ansible Galaxy Group repositories
 func (f *AnsibleGalaxyRepositoryFormatGroup) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
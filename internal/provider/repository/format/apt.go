@@ -148,6 +148,12 @@ func (f *AptRepositoryFormatHosted) UpdateStateFromApi(state any, api any) any {
 	return stateModel
 }
 
+func (f *AptRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryAptHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
 func (f *AptRepositoryFormatHosted) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryAptHostedModel)
 	var stateModel model.RepositoryAptHostedModel
@@ -236,6 +242,23 @@ func (f *AptRepositoryFormatProxy) UpdateStateFromApi(state any, api any) any {
 	return stateModel
 }
 
+func (f *AptRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryAptProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *AptRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryAptProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *AptRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryAptProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *AptRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryAptProxyModel)
 	var stateModel model.RepositoryAptProxyModel
@@ -134,6 +134,12 @@ func (f *CargoRepositoryFormatHosted) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *CargoRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositorCargoHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
 // --------------------------------------------
 // PROXY Cargo Format Functions
 // --------------------------------------------
@@ -237,6 +243,23 @@ func (f *CargoRepositoryFormatProxy) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *CargoRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryCargoProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *CargoRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryCargoProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *CargoRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryCargoProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *CargoRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryCargoProxyModel)
 	var stateModel model.RepositoryCargoProxyModel
@@ -360,11 +383,26 @@ func (f *CargoRepositoryFormatGroup) UpdatePlanForState(plan any) any {
 }
 
 func (f *CargoRepositoryFormatGroup) UpdateStateFromApi(state any, api any) any {
-	stateModel := (state).(model.RepositoryCargoGroupModel)
+	var stateModel model.RepositoryCargoGroupModel
+	// During import, state might be nil, so we create a new model
+	if state != nil {
+		stateModel = (state).(model.RepositoryCargoGroupModel)
+	}
 	stateModel.FromApiModel((api).(sonatyperepo.CargoGroupApiRepository))
 	return stateModel
 }
 
+func (f *CargoRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositoryCargoGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, types.StringNull())
+}
+
+func (f *CargoRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositoryCargoGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
+
 // --------------------------------------------
 // Common Functions
 // --------------------------------------------
@@ -151,6 +151,23 @@ func (f *CocoaPodsRepositoryFormatProxy) UpdateStateFromApi(state any, api any)
 	return stateModel
 }
 
+func (f *CocoaPodsRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryCocoaPodsProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *CocoaPodsRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryCocoaPodsProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *CocoaPodsRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryCocoaPodsProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *CocoaPodsRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryCocoaPodsProxyModel)
 	var stateModel model.RepositoryCocoaPodsProxyModel
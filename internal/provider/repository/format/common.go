@@ -188,6 +188,37 @@ func (f *BaseRepositoryFormat) UpdateStateFromPlanForNonApiFields(plan, state an
 	return state
 }
 
+// GroupMembershipFromPlan returns the planned membership for Group Repositories - nil for all others
+func (f *BaseRepositoryFormat) GroupMembershipFromPlan(plan any) *GroupMembership {
+	return nil
+}
+
+// UpdateGroupMemberNames replaces the members of Group Repositories - state is returned unchanged for all others
+func (f *BaseRepositoryFormat) UpdateGroupMemberNames(state any, memberNames []string) any {
+	return state
+}
+
+// DockerConnectorFromPlan returns the planned connector for Docker Repositories - nil for all others
+func (f *BaseRepositoryFormat) DockerConnectorFromPlan(plan any) *DockerConnector {
+	return nil
+}
+
+// DetachCleanupPolicy removes the named Cleanup Policy from Hosted and Proxy Repositories - state is returned
+// unchanged for all others
+func (f *BaseRepositoryFormat) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	return state
+}
+
+// DetachRoutingRule removes the Routing Rule from Proxy Repositories - state is returned unchanged for all others
+func (f *BaseRepositoryFormat) DetachRoutingRule(state any) any {
+	return state
+}
+
+// HasHttpAuthentication is true for Proxy Repositories that authenticate to their remote - false for all others
+func (f *BaseRepositoryFormat) HasHttpAuthentication(state any) bool {
+	return false
+}
+
 // RepositoryFormat that all Repository Formats must implement
 // --------------------------------------------
 type RepositoryFormat interface {
@@ -219,6 +250,12 @@ type RepositoryFormat interface {
 	GetRepositoryFirewallPccsEnabled(state any) bool
 	UpateStateWithCapability(state any, capability *sonatyperepo.CapabilityDTO) any
 	AdditionalSchemaDescription() string
+	GroupMembershipFromPlan(plan any) *GroupMembership
+	UpdateGroupMemberNames(state any, memberNames []string) any
+	DockerConnectorFromPlan(plan any) *DockerConnector
+	DetachCleanupPolicy(state any, cleanupPolicyName string) any
+	DetachRoutingRule(state any) any
+	HasHttpAuthentication(state any) bool
 }
 
 func resourceName(format string, repoType RepositoryType) string {
@@ -151,6 +151,23 @@ func (f *ComposerRepositoryFormat) UpdateStateFromApi(state any, api any) any {
 	return stateModel
 }
 
+func (f *ComposerRepositoryFormat) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryComposerProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *ComposerRepositoryFormat) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryComposerProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *ComposerRepositoryFormat) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryComposerProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *ComposerRepositoryFormat) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryComposerProxyModel)
 	var stateModel model.RepositoryComposerProxyModel
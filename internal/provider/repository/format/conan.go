@@ -137,6 +137,12 @@ func (f *ConanRepositoryFormatHosted) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *ConanRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositorConanHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
 // --------------------------------------------
 // PROXY Conan Format Functions
 // --------------------------------------------
@@ -240,6 +246,23 @@ func (f *ConanRepositoryFormatProxy) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *ConanRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryConanProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *ConanRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryConanProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *ConanRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryConanProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *ConanRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryConanProxyModel)
 	var stateModel model.RepositoryConanProxyModel
@@ -361,11 +384,26 @@ func (f *ConanRepositoryFormatGroup) UpdatePlanForState(plan any) any {
 }
 
 func (f *ConanRepositoryFormatGroup) UpdateStateFromApi(state any, api any) any {
-	stateModel := (state).(model.RepositoryConanGroupModel)
+	var stateModel model.RepositoryConanGroupModel
+	// During import, state might be nil, so we create a new model
+	if state != nil {
+		stateModel = (state).(model.RepositoryConanGroupModel)
+	}
 	stateModel.FromApiModel((api).(sonatyperepo.SimpleApiGroupDeployRepository))
 	return stateModel
 }
 
+func (f *ConanRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositoryConanGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, planModel.Group.WritableMember)
+}
+
+func (f *ConanRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositoryConanGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
+
 // --------------------------------------------
 // Common Functions
 // --------------------------------------------
@@ -151,6 +151,23 @@ func (f *CondaRepositoryFormatProxy) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *CondaRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryCondaProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *CondaRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryCondaProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *CondaRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryCondaProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *CondaRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryCondaProxyModel)
 	var stateModel model.RepositoryCondaProxyModel
@@ -156,6 +156,17 @@ func (f *DockerRepositoryFormatHosted) UpdateStateFromApi(state any, api any) an
 	return stateModel
 }
 
+func (f *DockerRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryDockerHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *DockerRepositoryFormatHosted) DockerConnectorFromPlan(plan any) *DockerConnector {
+	var planModel = (plan).(model.RepositoryDockerHostedModel)
+	return dockerConnectorFromModel(planModel.Name, REPO_TYPE_HOSTED, planModel.Docker.HttpPort, planModel.Docker.HttpsPort, planModel.Docker.Subdomain)
+}
+
 func (f *DockerRepositoryFormatHosted) ValidatePlanForNxrmVersion(plan any, version common.SystemVersion) []string {
 	var planModel = (plan).(model.RepositoryDockerHostedModel)
 	return validatePlanForDockerRespository(version, planModel.Docker.PathEnabled, planModel.Name.ValueString())
@@ -265,6 +276,28 @@ func (f *DockerRepositoryFormatProxy) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *DockerRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryDockerProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *DockerRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryDockerProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *DockerRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryDockerProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
+func (f *DockerRepositoryFormatProxy) DockerConnectorFromPlan(plan any) *DockerConnector {
+	var planModel = (plan).(model.RepositoryDockerProxyModel)
+	return dockerConnectorFromModel(planModel.Name, REPO_TYPE_PROXY, planModel.Docker.HttpPort, planModel.Docker.HttpsPort, planModel.Docker.Subdomain)
+}
+
 func (f *DockerRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryDockerProxyModel)
 	var stateModel model.RepositoryDockerProxyModel
@@ -417,6 +450,22 @@ func (f *DockerRepositoryFormatGroup) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *DockerRepositoryFormatGroup) DockerConnectorFromPlan(plan any) *DockerConnector {
+	var planModel = (plan).(model.RepositoryDockerGroupModel)
+	return dockerConnectorFromModel(planModel.Name, REPO_TYPE_GROUP, planModel.Docker.HttpPort, planModel.Docker.HttpsPort, planModel.Docker.Subdomain)
+}
+
+func (f *DockerRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositoryDockerGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, planModel.Group.WritableMember)
+}
+
+func (f *DockerRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositoryDockerGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
+
 func (f *DockerRepositoryFormatGroup) ValidatePlanForNxrmVersion(plan any, version common.SystemVersion) []string {
 	var planModel = (plan).(model.RepositoryDockerGroupModel)
 	return validatePlanForDockerRespository(version, planModel.Docker.PathEnabled, planModel.Name.ValueString())
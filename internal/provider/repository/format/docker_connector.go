@@ -0,0 +1,215 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"terraform-provider-sonatyperepo/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+)
+
+const (
+	DOCKER_CONNECTOR_ATTRIBUTE_HTTP_PORT  = "http_port"
+	DOCKER_CONNECTOR_ATTRIBUTE_HTTPS_PORT = "https_port"
+	DOCKER_CONNECTOR_ATTRIBUTE_SUBDOMAIN  = "subdomain"
+
+	errDockerConnectorPortInUse           = "Port %d is already used by the %s connector of Docker Repository '%s'"
+	errDockerConnectorPortInUseBySelf     = "Port %d cannot be used for both the HTTP and HTTPS connector"
+	errDockerConnectorSubdomainInUse      = "Subdomain '%s' is already used by Docker Repository '%s'"
+	errDockerConnectorUnsupportedRepoType = "unable to read connectors of Docker Repository '%s' with unsupported type '%s'"
+)
+
+// DockerConnector describes the connector ports and subdomain allocated to a Docker Repository - either
+// as planned, or as currently configured in Sonatype Nexus Repository.
+type DockerConnector struct {
+	RepositoryName string
+	RepositoryType string
+	HttpPort       *int32
+	HttpsPort      *int32
+	Subdomain      *string
+}
+
+// Equal is true if both connectors allocate the same ports and subdomain to the same Docker Repository
+func (c *DockerConnector) Equal(other *DockerConnector) bool {
+	if c == nil || other == nil {
+		return c == other
+	}
+	return c.RepositoryName == other.RepositoryName &&
+		equalPointers(c.HttpPort, other.HttpPort) &&
+		equalPointers(c.HttpsPort, other.HttpsPort) &&
+		equalPointers(c.Subdomain, other.Subdomain)
+}
+
+func equalPointers[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// DockerConnectorConflict is a connector attribute of a planned Docker Repository that collides with
+// another Docker Repository.
+type DockerConnectorConflict struct {
+	Attribute string
+	Message   string
+}
+
+// dockerConnectorFromModel builds a DockerConnector from Docker Repository model values. Values that
+// are not yet known are skipped, as nothing can be validated about them until apply.
+func dockerConnectorFromModel(name types.String, repositoryType RepositoryType, httpPort, httpsPort types.Int32, subdomain types.String) *DockerConnector {
+	if name.IsUnknown() || name.IsNull() {
+		return nil
+	}
+	connector := &DockerConnector{
+		RepositoryName: name.ValueString(),
+		RepositoryType: repositoryType.String(),
+	}
+	if !httpPort.IsUnknown() {
+		connector.HttpPort = httpPort.ValueInt32Pointer()
+	}
+	if !httpsPort.IsUnknown() {
+		connector.HttpsPort = httpsPort.ValueInt32Pointer()
+	}
+	if !subdomain.IsUnknown() && subdomain.ValueString() != "" {
+		connector.Subdomain = subdomain.ValueStringPointer()
+	}
+	return connector
+}
+
+func dockerConnectorFromApi(repositoryName, repositoryType string, api sonatyperepo.DockerAttributes) DockerConnector {
+	return DockerConnector{
+		RepositoryName: repositoryName,
+		RepositoryType: repositoryType,
+		HttpPort:       api.HttpPort,
+		HttpsPort:      api.HttpsPort,
+		Subdomain:      api.Subdomain,
+	}
+}
+
+// ListDockerConnectors reads the connector ports and subdomain of every Docker Repository in
+// Sonatype Nexus Repository.
+func ListDockerConnectors(ctx context.Context, apiClient common.RepositoryManagementService) ([]DockerConnector, *http.Response, error) {
+	repositories, httpResponse, err := apiClient.ListRepositories(ctx)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	connectors := make([]DockerConnector, 0)
+	for _, r := range repositories {
+		if strings.ToUpper(r.GetFormat()) != common.REPO_FORMAT_DOCKER {
+			continue
+		}
+
+		switch r.GetType() {
+		case REPO_TYPE_HOSTED.String():
+			api, httpResponse, err := apiClient.GetDockerHostedRepository(ctx, r.GetName())
+			if err != nil {
+				return nil, httpResponse, err
+			}
+			connectors = append(connectors, dockerConnectorFromApi(r.GetName(), r.GetType(), api.Docker))
+		case REPO_TYPE_PROXY.String():
+			api, _, httpResponse, err := apiClient.GetDockerProxyRepository(ctx, r.GetName())
+			if err != nil {
+				return nil, httpResponse, err
+			}
+			connectors = append(connectors, dockerConnectorFromApi(r.GetName(), r.GetType(), api.Docker))
+		case REPO_TYPE_GROUP.String():
+			api, httpResponse, err := apiClient.GetDockerGroupRepository(ctx, r.GetName())
+			if err != nil {
+				return nil, httpResponse, err
+			}
+			connectors = append(connectors, dockerConnectorFromApi(r.GetName(), r.GetType(), api.Docker))
+		default:
+			return nil, nil, fmt.Errorf(errDockerConnectorUnsupportedRepoType, r.GetName(), r.GetType())
+		}
+	}
+
+	return connectors, httpResponse, nil
+}
+
+// ValidateDockerConnector checks the planned connector ports and subdomain of a Docker Repository
+// against those already allocated to all other Docker Repositories. Nothing is read from Sonatype
+// Nexus Repository if the planned Docker Repository claims no port or subdomain.
+func ValidateDockerConnector(ctx context.Context, apiClient common.RepositoryManagementService, planned *DockerConnector) ([]DockerConnectorConflict, *http.Response, error) {
+	if planned.HttpPort == nil && planned.HttpsPort == nil && planned.Subdomain == nil {
+		return nil, nil, nil
+	}
+
+	existing, httpResponse, err := ListDockerConnectors(ctx, apiClient)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+	return findDockerConnectorConflicts(existing, planned), httpResponse, nil
+}
+
+// findDockerConnectorConflicts returns a conflict for every planned port or subdomain that is already
+// allocated - ports collide whether used for HTTP or HTTPS, and subdomains are compared case-insensitively.
+func findDockerConnectorConflicts(existing []DockerConnector, planned *DockerConnector) []DockerConnectorConflict {
+	conflicts := make([]DockerConnectorConflict, 0)
+
+	if planned.HttpPort != nil && planned.HttpsPort != nil && *planned.HttpPort == *planned.HttpsPort {
+		conflicts = append(conflicts, DockerConnectorConflict{
+			Attribute: DOCKER_CONNECTOR_ATTRIBUTE_HTTPS_PORT,
+			Message:   fmt.Sprintf(errDockerConnectorPortInUseBySelf, *planned.HttpsPort),
+		})
+	}
+
+	for _, other := range existing {
+		if other.RepositoryName == planned.RepositoryName {
+			continue
+		}
+
+		for _, p := range []struct {
+			attribute string
+			port      *int32
+		}{
+			{DOCKER_CONNECTOR_ATTRIBUTE_HTTP_PORT, planned.HttpPort},
+			{DOCKER_CONNECTOR_ATTRIBUTE_HTTPS_PORT, planned.HttpsPort},
+		} {
+			if p.port == nil {
+				continue
+			}
+			if other.HttpPort != nil && *other.HttpPort == *p.port {
+				conflicts = append(conflicts, DockerConnectorConflict{
+					Attribute: p.attribute,
+					Message:   fmt.Sprintf(errDockerConnectorPortInUse, *p.port, "HTTP", other.RepositoryName),
+				})
+			}
+			if other.HttpsPort != nil && *other.HttpsPort == *p.port {
+				conflicts = append(conflicts, DockerConnectorConflict{
+					Attribute: p.attribute,
+					Message:   fmt.Sprintf(errDockerConnectorPortInUse, *p.port, "HTTPS", other.RepositoryName),
+				})
+			}
+		}
+
+		if planned.Subdomain != nil && other.Subdomain != nil && strings.EqualFold(*planned.Subdomain, *other.Subdomain) {
+			conflicts = append(conflicts, DockerConnectorConflict{
+				Attribute: DOCKER_CONNECTOR_ATTRIBUTE_SUBDOMAIN,
+				Message:   fmt.Sprintf(errDockerConnectorSubdomainInUse, *planned.Subdomain, other.RepositoryName),
+			})
+		}
+	}
+
+	return conflicts
+}
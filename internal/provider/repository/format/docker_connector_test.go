@@ -0,0 +1,128 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format
+
+import (
+	"fmt"
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindDockerConnectorConflicts(t *testing.T) {
+	existing := []DockerConnector{
+		{RepositoryName: "docker-hosted", RepositoryType: "hosted", HttpPort: int32Pointer(8082), Subdomain: common.StringPointer("hosted")},
+		{RepositoryName: "docker-proxy", RepositoryType: "proxy", HttpsPort: int32Pointer(8443)},
+		{RepositoryName: "docker-group", RepositoryType: "group"},
+	}
+
+	testCases := []struct {
+		description string
+		planned     DockerConnector
+		expected    []DockerConnectorConflict
+	}{
+		{
+			description: "no connectors claimed",
+			planned:     DockerConnector{RepositoryName: "docker-new"},
+			expected:    []DockerConnectorConflict{},
+		},
+		{
+			description: "unused port and subdomain",
+			planned:     DockerConnector{RepositoryName: "docker-new", HttpPort: int32Pointer(8083), Subdomain: common.StringPointer("new")},
+			expected:    []DockerConnectorConflict{},
+		},
+		{
+			description: "existing repository keeps its own connectors",
+			planned:     DockerConnector{RepositoryName: "docker-hosted", HttpPort: int32Pointer(8082), Subdomain: common.StringPointer("hosted")},
+			expected:    []DockerConnectorConflict{},
+		},
+		{
+			description: "http port used for http",
+			planned:     DockerConnector{RepositoryName: "docker-new", HttpPort: int32Pointer(8082)},
+			expected: []DockerConnectorConflict{
+				{Attribute: DOCKER_CONNECTOR_ATTRIBUTE_HTTP_PORT, Message: fmt.Sprintf(errDockerConnectorPortInUse, 8082, "HTTP", "docker-hosted")},
+			},
+		},
+		{
+			description: "https port used for http",
+			planned:     DockerConnector{RepositoryName: "docker-new", HttpsPort: int32Pointer(8082)},
+			expected: []DockerConnectorConflict{
+				{Attribute: DOCKER_CONNECTOR_ATTRIBUTE_HTTPS_PORT, Message: fmt.Sprintf(errDockerConnectorPortInUse, 8082, "HTTP", "docker-hosted")},
+			},
+		},
+		{
+			description: "http port used for https",
+			planned:     DockerConnector{RepositoryName: "docker-new", HttpPort: int32Pointer(8443)},
+			expected: []DockerConnectorConflict{
+				{Attribute: DOCKER_CONNECTOR_ATTRIBUTE_HTTP_PORT, Message: fmt.Sprintf(errDockerConnectorPortInUse, 8443, "HTTPS", "docker-proxy")},
+			},
+		},
+		{
+			description: "same port for http and https",
+			planned:     DockerConnector{RepositoryName: "docker-new", HttpPort: int32Pointer(9000), HttpsPort: int32Pointer(9000)},
+			expected: []DockerConnectorConflict{
+				{Attribute: DOCKER_CONNECTOR_ATTRIBUTE_HTTPS_PORT, Message: fmt.Sprintf(errDockerConnectorPortInUseBySelf, 9000)},
+			},
+		},
+		{
+			description: "subdomain differing only by case",
+			planned:     DockerConnector{RepositoryName: "docker-new", Subdomain: common.StringPointer("HOSTED")},
+			expected: []DockerConnectorConflict{
+				{Attribute: DOCKER_CONNECTOR_ATTRIBUTE_SUBDOMAIN, Message: fmt.Sprintf(errDockerConnectorSubdomainInUse, "HOSTED", "docker-hosted")},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, findDockerConnectorConflicts(existing, &testCase.planned))
+		})
+	}
+}
+
+func TestDockerConnectorFromModel(t *testing.T) {
+	connector := dockerConnectorFromModel(
+		types.StringValue("docker-hosted"),
+		REPO_TYPE_HOSTED,
+		types.Int32Value(8082),
+		types.Int32Unknown(),
+		types.StringValue(""),
+	)
+
+	assert.Equal(t, "docker-hosted", connector.RepositoryName)
+	assert.Equal(t, "hosted", connector.RepositoryType)
+	assert.Equal(t, int32(8082), *connector.HttpPort)
+	assert.Nil(t, connector.HttpsPort)
+	assert.Nil(t, connector.Subdomain)
+}
+
+func TestDockerConnectorEqual(t *testing.T) {
+	subdomain := "docker"
+	connector := &DockerConnector{RepositoryName: "docker-hosted", HttpPort: int32Pointer(8082), Subdomain: &subdomain}
+
+	assert.True(t, connector.Equal(&DockerConnector{RepositoryName: "docker-hosted", HttpPort: int32Pointer(8082), Subdomain: common.StringPointer("docker")}))
+	assert.False(t, connector.Equal(&DockerConnector{RepositoryName: "docker-hosted", HttpPort: int32Pointer(8083), Subdomain: &subdomain}))
+	assert.False(t, connector.Equal(&DockerConnector{RepositoryName: "docker-hosted", HttpPort: int32Pointer(8082)}))
+	assert.False(t, connector.Equal(&DockerConnector{RepositoryName: "docker-other", HttpPort: int32Pointer(8082), Subdomain: &subdomain}))
+	assert.False(t, connector.Equal(nil))
+}
+
+func int32Pointer(i int32) *int32 {
+	return &i
+}
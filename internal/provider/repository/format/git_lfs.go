@@ -122,3 +122,9 @@ func (f *GitLfsRepositoryFormatHosted) UpdateStateFromApi(state any, api any) an
 	stateModel.FromApiModel((api).(sonatyperepo.SimpleApiHostedRepository))
 	return stateModel
 }
+
+func (f *GitLfsRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryGitLfsHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
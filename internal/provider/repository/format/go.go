@@ -159,6 +159,23 @@ func (f *GoRepositoryFormatProxy) UpdateStateFromApi(state any, api any) any {
 	return stateModel
 }
 
+func (f *GoRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryGoProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *GoRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryGoProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *GoRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryGoProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *GoRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryGoProxyModel)
 	var stateModel model.RepositoryGoProxyModel
@@ -289,6 +306,17 @@ func (f *GoRepositoryFormatGroup) UpdateStateFromApi(state any, api any) any {
 	return stateModel
 }
 
+func (f *GoRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositoryGoGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, types.StringNull())
+}
+
+func (f *GoRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositoryGoGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for Go Group repositories
 func (f *GoRepositoryFormatGroup) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
@@ -363,6 +391,12 @@ func (f *GoRepositoryFormatHosted) UpdateStateFromApi(state any, api any) any {
 	return stateModel
 }
 
+func (f *GoRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryGoHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for Go Hosted repositories
 func (f *GoRepositoryFormatHosted) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
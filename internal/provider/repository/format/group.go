@@ -17,13 +17,31 @@
 package format
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"terraform-provider-sonatyperepo/internal/provider/common"
+
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
 )
 
+// Error message constants for Group Repository membership validation
+const (
+	errGroupMembershipCycle           = "Group Repository membership would create a cycle: %s"
+	errGroupMemberFormatMismatch      = "Member Repository '%s' is of format '%s', but this Group Repository is of format '%s'"
+	errGroupWritableMemberNotMember   = "Writable Member '%s' must also be listed in `member_names`"
+	errGroupWritableMemberNotHosted   = "Writable Member '%s' must be a hosted Repository, but is a %s Repository"
+	groupMembershipCyclePathSeparator = " -> "
+)
+
 func commonGroupSchemaAttributes(includeDeploy bool) map[string]tfschema.Attribute {
 	attributes := map[string]tfschema.Attribute{
 		"member_names": func() tfschema.ListAttribute {
@@ -46,3 +64,260 @@ func commonGroupSchemaAttributes(includeDeploy bool) map[string]tfschema.Attribu
 		),
 	}
 }
+
+// GroupMembership describes the members of a Group Repository - either as planned, or
+// as currently configured in Sonatype Nexus Repository.
+type GroupMembership struct {
+	Name           string
+	MemberNames    []string
+	WritableMember *string
+}
+
+// groupMembershipFromModel builds a GroupMembership from Group Repository model values.
+// Member names that are not yet known (e.g. they reference a Repository that is yet to
+// be created) are skipped, as nothing can be validated about them until apply.
+func groupMembershipFromModel(name types.String, memberNames []types.String, writableMember types.String) *GroupMembership {
+	if name.IsUnknown() || name.IsNull() {
+		return nil
+	}
+	membership := &GroupMembership{
+		Name:        name.ValueString(),
+		MemberNames: make([]string, 0, len(memberNames)),
+	}
+	for _, m := range memberNames {
+		if m.IsUnknown() || m.IsNull() {
+			continue
+		}
+		membership.MemberNames = append(membership.MemberNames, m.ValueString())
+	}
+	if !writableMember.IsUnknown() && !writableMember.IsNull() {
+		membership.WritableMember = writableMember.ValueStringPointer()
+	}
+	return membership
+}
+
+// groupMemberNamesAsModel converts member names to Group Repository model values.
+func groupMemberNamesAsModel(memberNames []string) []types.String {
+	values := make([]types.String, 0, len(memberNames))
+	for _, m := range memberNames {
+		values = append(values, types.StringValue(m))
+	}
+	return values
+}
+
+// GroupRepositoryFormats returns the Repository Formats that support Group Repositories.
+func GroupRepositoryFormats() []RepositoryFormat {
+	return []RepositoryFormat{
+		&AlpineRepositoryFormatGroup{},
+		&AnsibleGalaxyRepositoryFormatGroup{},
+		&CargoRepositoryFormatGroup{},
+		&ConanRepositoryFormatGroup{},
+		&DockerRepositoryFormatGroup{},
+		&GoRepositoryFormatGroup{},
+		&HelmRepositoryFormatGroup{},
+		&MavenRepositoryFormatGroup{},
+		&NpmRepositoryFormatGroup{},
+		&NugetRepositoryFormatGroup{},
+		&PyPiRepositoryFormatGroup{},
+		&RRepositoryFormatGroup{},
+		&RawRepositoryFormatGroup{},
+		&RubyGemsRepositoryFormatGroup{},
+		&SwiftRepositoryFormatGroup{},
+		&TerraformRepositoryFormatGroup{},
+		&YumRepositoryFormatGroup{},
+	}
+}
+
+// GroupRepositoryFormatFor returns the Group Repository Format for the supplied format (as returned by
+// Sonatype Nexus Repository, e.g. "maven2"), or nil if the format does not support Group Repositories.
+func GroupRepositoryFormatFor(repositoryFormat string) RepositoryFormat {
+	for _, f := range GroupRepositoryFormats() {
+		if f.Key() == strings.ToUpper(repositoryFormat) {
+			return f
+		}
+	}
+	return nil
+}
+
+// GroupMemberNamesWith returns memberNames with memberName at position (appended if position is nil), and
+// whether this differs from memberNames.
+func GroupMemberNamesWith(memberNames []string, memberName string, position *int64) ([]string, bool) {
+	current := slices.Index(memberNames, memberName)
+	if position == nil {
+		if current >= 0 {
+			return memberNames, false
+		}
+		return append(slices.Clone(memberNames), memberName), true
+	}
+
+	others := slices.DeleteFunc(slices.Clone(memberNames), func(m string) bool { return m == memberName })
+	target := min(int(*position), len(others))
+	if current == target {
+		return memberNames, false
+	}
+	return slices.Insert(others, target, memberName), true
+}
+
+// GroupMemberNamesWithout returns memberNames without memberName, and whether this differs from memberNames.
+func GroupMemberNamesWithout(memberNames []string, memberName string) ([]string, bool) {
+	if !slices.Contains(memberNames, memberName) {
+		return memberNames, false
+	}
+	return slices.DeleteFunc(slices.Clone(memberNames), func(m string) bool { return m == memberName }), true
+}
+
+// ValidateGroupMembership builds the Group Repository membership graph from the Repositories that
+// already exist in Sonatype Nexus Repository combined with the planned membership of a Group
+// Repository, and returns a message for every cycle, format mismatch or invalid writable member found.
+//
+// Members that do not (yet) exist in Sonatype Nexus Repository are skipped, as they may be created
+// in the same apply.
+func ValidateGroupMembership(ctx context.Context, apiClient common.RepositoryManagementService, groupFormat string, planned *GroupMembership) ([]string, *http.Response, error) {
+	repositories, httpResponse, err := apiClient.ListRepositories(ctx)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	graph := newGroupMembershipGraph(func(repositoryName, repositoryFormat string) ([]string, error) {
+		membership, _, err := readGroupMembership(ctx, apiClient, repositoryName, repositoryFormat)
+		if err != nil || membership == nil {
+			return nil, err
+		}
+		return membership.MemberNames, nil
+	})
+	for _, r := range repositories {
+		graph.addRepository(r.GetName(), r.GetFormat(), r.GetType())
+	}
+
+	messages, err := graph.validate(groupFormat, planned)
+	return messages, httpResponse, err
+}
+
+// readGroupMembership reads the membership of an existing Group Repository of any format - nil if this
+// provider does not support Group Repositories of that format, so that such Groups are not followed.
+func readGroupMembership(ctx context.Context, apiClient common.RepositoryManagementService, repositoryName, repositoryFormat string) (*GroupMembership, *http.Response, error) {
+	groupFormat := GroupRepositoryFormatFor(repositoryFormat)
+	if groupFormat == nil {
+		tflog.Debug(ctx, fmt.Sprintf("Skipping members of Group Repository '%s' with unsupported format '%s'", repositoryName, repositoryFormat))
+		return nil, nil, nil
+	}
+
+	api, httpResponse, err := groupFormat.DoImportRequest(repositoryName, apiClient, ctx)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+	return groupFormat.GroupMembershipFromPlan(groupFormat.UpdateStateFromApi(nil, api)), httpResponse, nil
+}
+
+// groupMembershipRepository is the format and type of a Repository in the membership graph.
+type groupMembershipRepository struct {
+	format         string
+	repositoryType string
+}
+
+// groupMembershipGraph is the graph of Group Repositories to their members. Members of existing
+// Group Repositories are loaded lazily, so only Groups reachable from the planned Group are read.
+type groupMembershipGraph struct {
+	repositories map[string]groupMembershipRepository
+	members      map[string][]string
+	loadMembers  func(repositoryName, repositoryFormat string) ([]string, error)
+}
+
+func newGroupMembershipGraph(loadMembers func(repositoryName, repositoryFormat string) ([]string, error)) *groupMembershipGraph {
+	return &groupMembershipGraph{
+		repositories: make(map[string]groupMembershipRepository),
+		members:      make(map[string][]string),
+		loadMembers:  loadMembers,
+	}
+}
+
+func (g *groupMembershipGraph) addRepository(name, repositoryFormat, repositoryType string) {
+	g.repositories[name] = groupMembershipRepository{
+		format:         strings.ToLower(repositoryFormat),
+		repositoryType: strings.ToLower(repositoryType),
+	}
+}
+
+func (g *groupMembershipGraph) isGroup(name string) bool {
+	repository, exists := g.repositories[name]
+	return exists && repository.repositoryType == REPO_TYPE_GROUP.String()
+}
+
+func (g *groupMembershipGraph) membersOf(name string) ([]string, error) {
+	if members, loaded := g.members[name]; loaded {
+		return members, nil
+	}
+	if !g.isGroup(name) {
+		return nil, nil
+	}
+	members, err := g.loadMembers(name, g.repositories[name].format)
+	if err != nil {
+		return nil, err
+	}
+	g.members[name] = members
+	return members, nil
+}
+
+// validate checks the planned membership against the graph - the planned membership replaces
+// whatever membership the Group Repository currently has (if it exists at all).
+func (g *groupMembershipGraph) validate(groupFormat string, planned *GroupMembership) ([]string, error) {
+	messages := make([]string, 0)
+	g.members[planned.Name] = planned.MemberNames
+
+	for _, member := range planned.MemberNames {
+		repository, exists := g.repositories[member]
+		if exists && member != planned.Name && repository.format != strings.ToLower(groupFormat) {
+			messages = append(messages, fmt.Sprintf(errGroupMemberFormatMismatch, member, repository.format, strings.ToLower(groupFormat)))
+		}
+	}
+
+	cycle, err := g.findCycle(planned.Name)
+	if err != nil {
+		return nil, err
+	}
+	if cycle != nil {
+		messages = append(messages, fmt.Sprintf(errGroupMembershipCycle, strings.Join(cycle, groupMembershipCyclePathSeparator)))
+	}
+
+	if planned.WritableMember != nil && *planned.WritableMember != "" {
+		writableMember := *planned.WritableMember
+		if !slices.Contains(planned.MemberNames, writableMember) {
+			messages = append(messages, fmt.Sprintf(errGroupWritableMemberNotMember, writableMember))
+		} else if repository, exists := g.repositories[writableMember]; exists && repository.repositoryType != REPO_TYPE_HOSTED.String() {
+			messages = append(messages, fmt.Sprintf(errGroupWritableMemberNotHosted, writableMember, repository.repositoryType))
+		}
+	}
+
+	return messages, nil
+}
+
+// findCycle walks the graph depth-first from groupName and returns the path of the first cycle
+// leading back to groupName, or nil if there is none.
+func (g *groupMembershipGraph) findCycle(groupName string) ([]string, error) {
+	visited := make(map[string]bool)
+
+	var visit func(name string, path []string) ([]string, error)
+	visit = func(name string, path []string) ([]string, error) {
+		members, err := g.membersOf(name)
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range members {
+			memberPath := append(slices.Clone(path), member)
+			if member == groupName {
+				return memberPath, nil
+			}
+			if visited[member] {
+				continue
+			}
+			visited[member] = true
+			cycle, err := visit(member, memberPath)
+			if err != nil || cycle != nil {
+				return cycle, err
+			}
+		}
+		return nil, nil
+	}
+
+	return visit(groupName, []string{groupName})
+}
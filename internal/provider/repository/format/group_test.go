@@ -0,0 +1,271 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format
+
+import (
+	"context"
+	"fmt"
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type testGroupRepository struct {
+	name           string
+	format         string
+	repositoryType string
+	members        []string
+}
+
+type groupMembershipValidateTestCase struct {
+	description      string
+	existing         []testGroupRepository
+	groupFormat      string
+	planned          GroupMembership
+	expectedMessages []string
+}
+
+func newTestGroupMembershipGraph(existing []testGroupRepository) *groupMembershipGraph {
+	members := make(map[string][]string)
+	graph := newGroupMembershipGraph(func(repositoryName, repositoryFormat string) ([]string, error) {
+		return members[repositoryName], nil
+	})
+	for _, r := range existing {
+		graph.addRepository(r.name, r.format, r.repositoryType)
+		members[r.name] = r.members
+	}
+	return graph
+}
+
+func TestGroupMembershipGraphValidate(t *testing.T) {
+	testCases := []groupMembershipValidateTestCase{
+		{
+			description: "valid membership",
+			existing: []testGroupRepository{
+				{name: "maven-releases", format: "maven2", repositoryType: "hosted"},
+				{name: "maven-central", format: "maven2", repositoryType: "proxy"},
+			},
+			groupFormat: common.REPO_FORMAT_MAVEN,
+			planned: GroupMembership{
+				Name:        "maven-public",
+				MemberNames: []string{"maven-releases", "maven-central"},
+			},
+			expectedMessages: []string{},
+		},
+		{
+			description: "members yet to be created are skipped",
+			existing:    []testGroupRepository{},
+			groupFormat: common.REPO_FORMAT_MAVEN,
+			planned: GroupMembership{
+				Name:        "maven-public",
+				MemberNames: []string{"maven-releases"},
+			},
+			expectedMessages: []string{},
+		},
+		{
+			description: "format mismatch",
+			existing: []testGroupRepository{
+				{name: "npm-hosted", format: "npm", repositoryType: "hosted"},
+			},
+			groupFormat: common.REPO_FORMAT_MAVEN,
+			planned: GroupMembership{
+				Name:        "maven-public",
+				MemberNames: []string{"npm-hosted"},
+			},
+			expectedMessages: []string{
+				fmt.Sprintf(errGroupMemberFormatMismatch, "npm-hosted", "npm", "maven2"),
+			},
+		},
+		{
+			description: "group is a member of itself",
+			existing:    []testGroupRepository{},
+			groupFormat: common.REPO_FORMAT_RAW,
+			planned: GroupMembership{
+				Name:        "raw-group",
+				MemberNames: []string{"raw-group"},
+			},
+			expectedMessages: []string{
+				fmt.Sprintf(errGroupMembershipCycle, "raw-group -> raw-group"),
+			},
+		},
+		{
+			description: "cycle through nested groups",
+			existing: []testGroupRepository{
+				{name: "raw-group-a", format: "raw", repositoryType: "group", members: []string{"raw-hosted", "raw-group-b"}},
+				{name: "raw-group-b", format: "raw", repositoryType: "group", members: []string{"raw-group-c"}},
+				{name: "raw-group-c", format: "raw", repositoryType: "group", members: []string{}},
+				{name: "raw-hosted", format: "raw", repositoryType: "hosted"},
+			},
+			groupFormat: common.REPO_FORMAT_RAW,
+			planned: GroupMembership{
+				Name:        "raw-group-c",
+				MemberNames: []string{"raw-group-a"},
+			},
+			expectedMessages: []string{
+				fmt.Sprintf(errGroupMembershipCycle, "raw-group-c -> raw-group-a -> raw-group-b -> raw-group-c"),
+			},
+		},
+		{
+			description: "planned membership replaces existing membership",
+			existing: []testGroupRepository{
+				{name: "raw-group-a", format: "raw", repositoryType: "group", members: []string{"raw-group-b"}},
+				{name: "raw-group-b", format: "raw", repositoryType: "group", members: []string{"raw-group-a"}},
+			},
+			groupFormat: common.REPO_FORMAT_RAW,
+			planned: GroupMembership{
+				Name:        "raw-group-b",
+				MemberNames: []string{},
+			},
+			expectedMessages: []string{},
+		},
+		{
+			description: "writable member not a member",
+			existing: []testGroupRepository{
+				{name: "npm-hosted", format: "npm", repositoryType: "hosted"},
+			},
+			groupFormat: common.REPO_FORMAT_NPM,
+			planned: GroupMembership{
+				Name:           "npm-group",
+				MemberNames:    []string{},
+				WritableMember: common.StringPointer("npm-hosted"),
+			},
+			expectedMessages: []string{
+				fmt.Sprintf(errGroupWritableMemberNotMember, "npm-hosted"),
+			},
+		},
+		{
+			description: "writable member not hosted",
+			existing: []testGroupRepository{
+				{name: "npm-proxy", format: "npm", repositoryType: "proxy"},
+			},
+			groupFormat: common.REPO_FORMAT_NPM,
+			planned: GroupMembership{
+				Name:           "npm-group",
+				MemberNames:    []string{"npm-proxy"},
+				WritableMember: common.StringPointer("npm-proxy"),
+			},
+			expectedMessages: []string{
+				fmt.Sprintf(errGroupWritableMemberNotHosted, "npm-proxy", "proxy"),
+			},
+		},
+		{
+			description: "valid writable member",
+			existing: []testGroupRepository{
+				{name: "docker-hosted", format: "docker", repositoryType: "hosted"},
+			},
+			groupFormat: common.REPO_FORMAT_DOCKER,
+			planned: GroupMembership{
+				Name:           "docker-group",
+				MemberNames:    []string{"docker-hosted"},
+				WritableMember: common.StringPointer("docker-hosted"),
+			},
+			expectedMessages: []string{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			graph := newTestGroupMembershipGraph(testCase.existing)
+			messages, err := graph.validate(testCase.groupFormat, &testCase.planned)
+			assert.NoError(t, err)
+			assert.Equal(t, testCase.expectedMessages, messages)
+		})
+	}
+}
+
+func TestGroupMembershipGraphLoadsOnlyReachableGroups(t *testing.T) {
+	loaded := make([]string, 0)
+	graph := newGroupMembershipGraph(func(repositoryName, repositoryFormat string) ([]string, error) {
+		loaded = append(loaded, repositoryName)
+		return []string{}, nil
+	})
+	graph.addRepository("raw-group-a", "raw", "group")
+	graph.addRepository("raw-group-b", "raw", "group")
+	graph.addRepository("raw-hosted", "raw", "hosted")
+
+	_, err := graph.validate(common.REPO_FORMAT_RAW, &GroupMembership{
+		Name:        "raw-group-new",
+		MemberNames: []string{"raw-group-a", "raw-hosted"},
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"raw-group-a"}, loaded)
+}
+
+func TestReadGroupMembershipSkipsUnsupportedFormat(t *testing.T) {
+	membership, httpResponse, err := readGroupMembership(context.Background(), nil, "bower-group", "bower")
+
+	assert.NoError(t, err)
+	assert.Nil(t, httpResponse)
+	assert.Nil(t, membership)
+}
+
+func TestGroupMembershipFromModel(t *testing.T) {
+	membership := groupMembershipFromModel(
+		types.StringValue("npm-group"),
+		[]types.String{types.StringValue("npm-hosted"), types.StringUnknown(), types.StringValue("npm-proxy")},
+		types.StringValue("npm-hosted"),
+	)
+
+	assert.Equal(t, "npm-group", membership.Name)
+	assert.Equal(t, []string{"npm-hosted", "npm-proxy"}, membership.MemberNames)
+	assert.Equal(t, "npm-hosted", *membership.WritableMember)
+
+	assert.Nil(t, groupMembershipFromModel(types.StringUnknown(), []types.String{}, types.StringNull()))
+}
+
+func TestGroupMemberNamesWith(t *testing.T) {
+	testCases := []struct {
+		description     string
+		memberNames     []string
+		position        *int64
+		expectedMembers []string
+		expectedChanged bool
+	}{
+		{"appended when absent", []string{"a", "b"}, nil, []string{"a", "b", "c"}, true},
+		{"unchanged when present", []string{"a", "c", "b"}, nil, []string{"a", "c", "b"}, false},
+		{"inserted at position", []string{"a", "b"}, int64Pointer(0), []string{"c", "a", "b"}, true},
+		{"moved to position", []string{"c", "a", "b"}, int64Pointer(2), []string{"a", "b", "c"}, true},
+		{"unchanged at position", []string{"a", "c", "b"}, int64Pointer(1), []string{"a", "c", "b"}, false},
+		{"position beyond end is last", []string{"a", "b"}, int64Pointer(10), []string{"a", "b", "c"}, true},
+		{"unchanged when last and position beyond end", []string{"a", "b", "c"}, int64Pointer(10), []string{"a", "b", "c"}, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.description, func(t *testing.T) {
+			memberNames, changed := GroupMemberNamesWith(testCase.memberNames, "c", testCase.position)
+			assert.Equal(t, testCase.expectedChanged, changed)
+			assert.Equal(t, testCase.expectedMembers, memberNames)
+		})
+	}
+}
+
+func TestGroupMemberNamesWithout(t *testing.T) {
+	memberNames, changed := GroupMemberNamesWithout([]string{"a", "c", "b"}, "c")
+	assert.True(t, changed)
+	assert.Equal(t, []string{"a", "b"}, memberNames)
+
+	memberNames, changed = GroupMemberNamesWithout([]string{"a", "b"}, "c")
+	assert.False(t, changed)
+	assert.Equal(t, []string{"a", "b"}, memberNames)
+}
+
+func int64Pointer(i int64) *int64 {
+	return &i
+}
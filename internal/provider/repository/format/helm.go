@@ -122,6 +122,12 @@ func (f *HelmRepositoryFormatHosted) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *HelmRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryHelmHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for Helm Hosted repositories
 func (f *HelmRepositoryFormatHosted) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
@@ -206,6 +212,23 @@ func (f *HelmRepositoryFormatProxy) UpdateStateFromApi(state any, api any) any {
 	return stateModel
 }
 
+func (f *HelmRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryHelmProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *HelmRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryHelmProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *HelmRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryHelmProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *HelmRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryHelmProxyModel)
 	var stateModel model.RepositoryHelmProxyModel
@@ -282,3 +305,14 @@ func (f *HelmRepositoryFormatGroup) UpdateStateFromApi(state any, api any) any {
 	stateModel.FromApiModel((api).(sonatyperepo.SimpleApiGroupRepository))
 	return stateModel
 }
+
+func (f *HelmRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositoryHelmGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, types.StringNull())
+}
+
+func (f *HelmRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositoryHelmGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
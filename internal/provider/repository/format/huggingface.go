@@ -151,6 +151,23 @@ func (f *HuggingFaceRepositoryFormatProxy) UpdateStateFromApi(state any, api any
 	return stateModel
 }
 
+func (f *HuggingFaceRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryHuggingFaceProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *HuggingFaceRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryHuggingFaceProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *HuggingFaceRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryHuggingFaceProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *HuggingFaceRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryHuggingFaceProxyModel)
 	var stateModel model.RepositoryHuggingFaceProxyModel
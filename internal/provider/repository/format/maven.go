@@ -137,6 +137,12 @@ func (f *MavenRepositoryFormatHosted) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *MavenRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryMavenHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
 // --------------------------------------------
 // PROXY Maven Format Functions
 // --------------------------------------------
@@ -240,6 +246,23 @@ func (f *MavenRepositoryFormatProxy) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *MavenRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryMavenProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *MavenRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryMavenProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *MavenRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryMavenProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *MavenRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryMavenProxyModel)
 	var stateModel model.RepositoryMavenProxyModel
@@ -380,6 +403,17 @@ func (f *MavenRepositoryFormatGroup) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *MavenRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositoryMavenGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, types.StringNull())
+}
+
+func (f *MavenRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositoryMavenGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
+
 func (f *MavenRepositoryFormatGroup) AdditionalSchemaDescription() string {
 	return `
 
@@ -123,6 +123,12 @@ func (f *NpmRepositoryFormatHosted) UpdateStateFromApi(state any, api any) any {
 	return stateModel
 }
 
+func (f *NpmRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryNpmHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for NPM Hosted repositories
 func (f *NpmRepositoryFormatHosted) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
@@ -238,6 +244,23 @@ func (f *NpmRepositoryFormatProxy) UpdateStateFromApi(state any, api any) any {
 	return stateModel
 }
 
+func (f *NpmRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryNpmProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *NpmRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryNpmProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *NpmRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryNpmProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *NpmRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryNpmProxyModel)
 	var stateModel model.RepositoryNpmProxyModel
@@ -386,6 +409,17 @@ func (f *NpmRepositoryFormatGroup) UpdateStateFromApi(state any, api any) any {
 	return stateModel
 }
 
+func (f *NpmRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositoryNpmGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, planModel.Group.WritableMember)
+}
+
+func (f *NpmRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositoryNpmGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for NPM Group repositories
 func (f *NpmRepositoryFormatGroup) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
@@ -126,6 +126,12 @@ func (f *NugetRepositoryFormatHosted) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *NugetRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryNugetHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for NuGet Hosted repositories
 func (f *NugetRepositoryFormatHosted) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
@@ -239,6 +245,23 @@ func (f *NugetRepositoryFormatProxy) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *NugetRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryNugetProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *NugetRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryNugetProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *NugetRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryNugetProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *NugetRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryNugetProxyModel)
 	var stateModel model.RepositoryNugetProxyModel
@@ -369,6 +392,17 @@ func (f *NugetRepositoryFormatGroup) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *NugetRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositoryNugetGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, types.StringNull())
+}
+
+func (f *NugetRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositoryNugetGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for NuGet Group repositories
 func (f *NugetRepositoryFormatGroup) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
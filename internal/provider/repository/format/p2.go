@@ -124,6 +124,23 @@ func (f *P2RepositoryFormatProxy) UpdateStateFromApi(state any, api any) any {
 	return stateModel
 }
 
+func (f *P2RepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryP2ProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *P2RepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryP2ProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *P2RepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryP2ProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *P2RepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryP2ProxyModel)
 	var stateModel model.RepositoryP2ProxyModel
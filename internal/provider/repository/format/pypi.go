@@ -122,6 +122,12 @@ func (f *PyPiRepositoryFormatHosted) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *PyPiRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryPyPiHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for PyPI Hosted repositories
 func (f *PyPiRepositoryFormatHosted) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
@@ -234,6 +240,23 @@ func (f *PyPiRepositoryFormatProxy) UpdateStateFromApi(state any, api any) any {
 	return stateModel
 }
 
+func (f *PyPiRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryPyPiProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *PyPiRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryPyPiProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *PyPiRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryPyPiProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *PyPiRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryPyPiProxyModel)
 	var stateModel model.RepositoryPyPiProxyModel
@@ -382,6 +405,17 @@ func (f *PyPiRepositoryFormatGroup) UpdateStateFromApi(state any, api any) any {
 	return stateModel
 }
 
+func (f *PyPiRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositoryPyPiGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, planModel.Group.WritableMember)
+}
+
+func (f *PyPiRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositoryPyPiGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for PyPI Group repositories
 func (f *PyPiRepositoryFormatGroup) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
@@ -0,0 +1,258 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	errDetachUnsupportedFormat = "Repository '%s' has format '%s' and type '%s' which is not supported"
+	errDetachAuthentication    = "Repository '%s' uses HTTP authentication - it cannot be updated without its credentials, which Sonatype Nexus Repository does not return"
+)
+
+// RepositoryUsage describes the Blob Store, Cleanup Policies and Routing Rule a Repository depends upon.
+type RepositoryUsage struct {
+	Name               string
+	Format             string
+	Type               string
+	BlobStoreName      string
+	CleanupPolicyNames []string
+	RoutingRuleName    string
+}
+
+// repositoryUsageApi captures the parts of any Repository API response that reference other objects.
+type repositoryUsageApi struct {
+	Storage struct {
+		BlobStoreName string `json:"blobStoreName"`
+	} `json:"storage"`
+	Cleanup *struct {
+		PolicyNames []string `json:"policyNames"`
+	} `json:"cleanup"`
+	RoutingRule     *string `json:"routingRule"`
+	RoutingRuleName *string `json:"routingRuleName"`
+}
+
+// RepositoryFormats returns a Repository Format for every format and type supported by this provider.
+func RepositoryFormats() map[RepositoryType][]RepositoryFormat {
+	return map[RepositoryType][]RepositoryFormat{
+		REPO_TYPE_HOSTED: {
+			&AlpineRepositoryFormatHosted{},
+			&AnsibleGalaxyRepositoryFormatHosted{},
+			&AptRepositoryFormatHosted{},
+			&CargoRepositoryFormatHosted{},
+			&ConanRepositoryFormatHosted{},
+			&DockerRepositoryFormatHosted{},
+			&GitLfsRepositoryFormatHosted{},
+			&GoRepositoryFormatHosted{},
+			&HelmRepositoryFormatHosted{},
+			&MavenRepositoryFormatHosted{},
+			&NpmRepositoryFormatHosted{},
+			&NugetRepositoryFormatHosted{},
+			&PyPiRepositoryFormatHosted{},
+			&RRepositoryFormatHosted{},
+			&RawRepositoryFormatHosted{},
+			&RubyGemsRepositoryFormatHosted{},
+			&TerraformRepositoryFormatHosted{},
+			&YumRepositoryFormatHosted{},
+		},
+		REPO_TYPE_PROXY: {
+			&AlpineRepositoryFormatProxy{},
+			&AnsibleGalaxyRepositoryFormatProxy{},
+			&AptRepositoryFormatProxy{},
+			&CargoRepositoryFormatProxy{},
+			&CocoaPodsRepositoryFormatProxy{},
+			&ComposerRepositoryFormatProxy{},
+			&ConanRepositoryFormatProxy{},
+			&CondaRepositoryFormatProxy{},
+			&DockerRepositoryFormatProxy{},
+			&GoRepositoryFormatProxy{},
+			&HelmRepositoryFormatProxy{},
+			&HuggingFaceRepositoryFormatProxy{},
+			&MavenRepositoryFormatProxy{},
+			&NpmRepositoryFormatProxy{},
+			&NugetRepositoryFormatProxy{},
+			&P2RepositoryFormatProxy{},
+			&PyPiRepositoryFormatProxy{},
+			&RRepositoryFormatProxy{},
+			&RawRepositoryFormatProxy{},
+			&RubyGemsRepositoryFormatProxy{},
+			&SwiftRepositoryFormatProxy{},
+			&TerraformRepositoryFormatProxy{},
+			&YumRepositoryFormatProxy{},
+		},
+		REPO_TYPE_GROUP: GroupRepositoryFormats(),
+	}
+}
+
+// RepositoryFormatFor returns the Repository Format for the supplied format and type (as returned by
+// Sonatype Nexus Repository, e.g. "maven2" and "hosted"), or nil if this provider does not support it.
+func RepositoryFormatFor(repositoryFormat, repositoryType string) RepositoryFormat {
+	for t, formats := range RepositoryFormats() {
+		if t.String() != repositoryType {
+			continue
+		}
+		for _, f := range formats {
+			if f.Key() == strings.ToUpper(repositoryFormat) {
+				return f
+			}
+		}
+	}
+	return nil
+}
+
+// ListRepositoryUsage reads what every Repository in Sonatype Nexus Repository depends upon. Repositories
+// of a format this provider does not support are skipped.
+func ListRepositoryUsage(ctx context.Context, apiClient common.RepositoryManagementService) ([]RepositoryUsage, *http.Response, error) {
+	repositories, httpResponse, err := apiClient.ListRepositories(ctx)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	usage := make([]RepositoryUsage, 0, len(repositories))
+	for _, r := range repositories {
+		repositoryFormat := RepositoryFormatFor(r.GetFormat(), r.GetType())
+		if repositoryFormat == nil {
+			tflog.Debug(ctx, fmt.Sprintf("Skipping Repository '%s' with unsupported format '%s' and type '%s'", r.GetName(), r.GetFormat(), r.GetType()))
+			continue
+		}
+
+		api, httpResponse, err := repositoryFormat.DoImportRequest(r.GetName(), apiClient, ctx)
+		if err != nil {
+			return nil, httpResponse, err
+		}
+
+		repositoryUsage, err := repositoryUsageFromApi(api)
+		if err != nil {
+			return nil, nil, err
+		}
+		repositoryUsage.Name = r.GetName()
+		repositoryUsage.Format = r.GetFormat()
+		repositoryUsage.Type = r.GetType()
+		usage = append(usage, repositoryUsage)
+	}
+
+	return usage, httpResponse, nil
+}
+
+// repositoryUsageFromApi extracts the objects referenced by a Repository API response of any format.
+func repositoryUsageFromApi(api any) (RepositoryUsage, error) {
+	if withFirewall, ok := api.(ProxyApiResponseWithFirewall); ok {
+		api = withFirewall.Repository
+	}
+
+	b, err := json.Marshal(api)
+	if err != nil {
+		return RepositoryUsage{}, err
+	}
+	var parsed repositoryUsageApi
+	if err := json.Unmarshal(b, &parsed); err != nil {
+		return RepositoryUsage{}, err
+	}
+
+	usage := RepositoryUsage{
+		BlobStoreName:      parsed.Storage.BlobStoreName,
+		CleanupPolicyNames: make([]string, 0),
+	}
+	if parsed.Cleanup != nil {
+		usage.CleanupPolicyNames = append(usage.CleanupPolicyNames, parsed.Cleanup.PolicyNames...)
+	}
+	if parsed.RoutingRuleName != nil {
+		usage.RoutingRuleName = *parsed.RoutingRuleName
+	} else if parsed.RoutingRule != nil {
+		usage.RoutingRuleName = *parsed.RoutingRule
+	}
+	return usage, nil
+}
+
+// RepositoriesUsingBlobStore returns the names of the Repositories storing content in the named Blob Store.
+func RepositoriesUsingBlobStore(usage []RepositoryUsage, blobStoreName string) []string {
+	names := make([]string, 0)
+	for _, u := range usage {
+		if u.BlobStoreName == blobStoreName {
+			names = append(names, u.Name)
+		}
+	}
+	return names
+}
+
+// RepositoriesUsingCleanupPolicy returns the Repositories that have the named Cleanup Policy applied.
+func RepositoriesUsingCleanupPolicy(usage []RepositoryUsage, cleanupPolicyName string) []RepositoryUsage {
+	repositories := make([]RepositoryUsage, 0)
+	for _, u := range usage {
+		if slices.Contains(u.CleanupPolicyNames, cleanupPolicyName) {
+			repositories = append(repositories, u)
+		}
+	}
+	return repositories
+}
+
+// RepositoriesUsingRoutingRule returns the Repositories that have the named Routing Rule applied.
+func RepositoriesUsingRoutingRule(usage []RepositoryUsage, routingRuleName string) []RepositoryUsage {
+	repositories := make([]RepositoryUsage, 0)
+	for _, u := range usage {
+		if u.RoutingRuleName == routingRuleName {
+			repositories = append(repositories, u)
+		}
+	}
+	return repositories
+}
+
+// DetachCleanupPolicy removes the named Cleanup Policy from a Repository of any format.
+func DetachCleanupPolicy(ctx context.Context, apiClient common.RepositoryManagementService, repository RepositoryUsage, cleanupPolicyName string) (*http.Response, error) {
+	return updateRepositoryUsage(ctx, apiClient, repository, func(repositoryFormat RepositoryFormat, state any) any {
+		return repositoryFormat.DetachCleanupPolicy(state, cleanupPolicyName)
+	})
+}
+
+// DetachRoutingRule removes the Routing Rule from a Repository of any format.
+func DetachRoutingRule(ctx context.Context, apiClient common.RepositoryManagementService, repository RepositoryUsage) (*http.Response, error) {
+	return updateRepositoryUsage(ctx, apiClient, repository, func(repositoryFormat RepositoryFormat, state any) any {
+		return repositoryFormat.DetachRoutingRule(state)
+	})
+}
+
+// updateRepositoryUsage reads a Repository of any format, applies modify to its state model and writes the
+// result back using the update call for the Repository's format.
+func updateRepositoryUsage(ctx context.Context, apiClient common.RepositoryManagementService, repository RepositoryUsage, modify func(repositoryFormat RepositoryFormat, state any) any) (*http.Response, error) {
+	repositoryFormat := RepositoryFormatFor(repository.Format, repository.Type)
+	if repositoryFormat == nil {
+		return nil, fmt.Errorf(errDetachUnsupportedFormat, repository.Name, repository.Format, repository.Type)
+	}
+
+	api, httpResponse, err := repositoryFormat.DoImportRequest(repository.Name, apiClient, ctx)
+	if err != nil {
+		return httpResponse, err
+	}
+	state := repositoryFormat.UpdateStateFromApi(nil, api)
+	if repositoryFormat.HasHttpAuthentication(state) {
+		return nil, fmt.Errorf(errDetachAuthentication, repository.Name)
+	}
+	plan := modify(repositoryFormat, state)
+
+	tflog.Debug(ctx, fmt.Sprintf("Updating Repository '%s' to remove references before deletion", repository.Name))
+	return repositoryFormat.DoUpdateRequest(plan, state, apiClient, ctx)
+}
@@ -0,0 +1,143 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package format
+
+import (
+	"testing"
+
+	"terraform-provider-sonatyperepo/internal/provider/model"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRepositoryFormatFor(t *testing.T) {
+	assert.IsType(t, &MavenRepositoryFormatHosted{}, RepositoryFormatFor("maven2", "hosted"))
+	assert.IsType(t, &DockerRepositoryFormatProxy{}, RepositoryFormatFor("docker", "proxy"))
+	assert.IsType(t, &RawRepositoryFormatGroup{}, RepositoryFormatFor("raw", "group"))
+	assert.Nil(t, RepositoryFormatFor("apt", "group"))
+	assert.Nil(t, RepositoryFormatFor("bower", "hosted"))
+}
+
+func TestRepositoryUsageFromApi(t *testing.T) {
+	testCases := []struct {
+		name     string
+		api      any
+		expected RepositoryUsage
+	}{
+		{
+			name: "hosted without cleanup",
+			api: map[string]any{
+				"name":    "hosted",
+				"storage": map[string]any{"blobStoreName": "default"},
+			},
+			expected: RepositoryUsage{BlobStoreName: "default", CleanupPolicyNames: []string{}},
+		},
+		{
+			name: "proxy with firewall wrapper",
+			api: ProxyApiResponseWithFirewall{
+				Repository: map[string]any{
+					"storage":         map[string]any{"blobStoreName": "proxy-store"},
+					"cleanup":         map[string]any{"policyNames": []string{"a", "b"}},
+					"routingRuleName": "block-internal",
+				},
+			},
+			expected: RepositoryUsage{BlobStoreName: "proxy-store", CleanupPolicyNames: []string{"a", "b"}, RoutingRuleName: "block-internal"},
+		},
+		{
+			name: "group with routing rule",
+			api: map[string]any{
+				"storage":     map[string]any{"blobStoreName": "group-store"},
+				"routingRule": "group-rule",
+			},
+			expected: RepositoryUsage{BlobStoreName: "group-store", CleanupPolicyNames: []string{}, RoutingRuleName: "group-rule"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			usage, err := repositoryUsageFromApi(tc.api)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, usage)
+		})
+	}
+}
+
+func TestRepositoriesUsingBlobStore(t *testing.T) {
+	usage := []RepositoryUsage{
+		{Name: "a", BlobStoreName: "default"},
+		{Name: "b", BlobStoreName: "other"},
+		{Name: "c", BlobStoreName: "default"},
+	}
+
+	assert.Equal(t, []string{"a", "c"}, RepositoriesUsingBlobStore(usage, "default"))
+	assert.Empty(t, RepositoriesUsingBlobStore(usage, "unused"))
+}
+
+func TestRepositoriesUsingCleanupPolicy(t *testing.T) {
+	usage := []RepositoryUsage{
+		{Name: "a", CleanupPolicyNames: []string{"weekly", "monthly"}},
+		{Name: "b", CleanupPolicyNames: []string{}},
+		{Name: "c", CleanupPolicyNames: []string{"monthly"}},
+	}
+
+	assert.Equal(t, []RepositoryUsage{usage[0], usage[2]}, RepositoriesUsingCleanupPolicy(usage, "monthly"))
+	assert.Empty(t, RepositoriesUsingCleanupPolicy(usage, "unused"))
+}
+
+func TestRepositoriesUsingRoutingRule(t *testing.T) {
+	usage := []RepositoryUsage{
+		{Name: "a", RoutingRuleName: "block-internal"},
+		{Name: "b"},
+		{Name: "c", RoutingRuleName: "allow-all"},
+	}
+
+	assert.Equal(t, []RepositoryUsage{usage[0]}, RepositoriesUsingRoutingRule(usage, "block-internal"))
+	assert.Empty(t, RepositoriesUsingRoutingRule(usage, "unused"))
+}
+
+func TestDetachCleanupPolicy(t *testing.T) {
+	state := model.RepositoryMavenHostedModel{}
+	state.Cleanup = &model.RepositoryCleanupModel{
+		PolicyNames: []types.String{types.StringValue("weekly"), types.StringValue("monthly")},
+	}
+	repositoryFormat := &MavenRepositoryFormatHosted{}
+
+	detached := repositoryFormat.DetachCleanupPolicy(state, "monthly").(model.RepositoryMavenHostedModel)
+	assert.Equal(t, []types.String{types.StringValue("weekly")}, detached.Cleanup.PolicyNames)
+	assert.Len(t, state.Cleanup.PolicyNames, 2, "state must not be modified")
+
+	detached = repositoryFormat.DetachCleanupPolicy(detached, "weekly").(model.RepositoryMavenHostedModel)
+	assert.Nil(t, detached.Cleanup)
+
+	group := model.RepositoryMavenGroupModel{}
+	assert.Equal(t, group, (&MavenRepositoryFormatGroup{}).DetachCleanupPolicy(group, "weekly"))
+}
+
+func TestDetachRoutingRule(t *testing.T) {
+	state := model.RepositoryRawProxyModel{}
+	state.RoutingRule = types.StringValue("block-internal")
+	repositoryFormat := &RawRepositoryFormatProxy{}
+
+	detached := repositoryFormat.DetachRoutingRule(state).(model.RepositoryRawProxyModel)
+	assert.True(t, detached.RoutingRule.IsNull())
+	assert.False(t, repositoryFormat.HasHttpAuthentication(detached))
+
+	detached.HttpClient.Authentication = &model.RepositoryHttpClientAuthenticationModel{}
+	assert.True(t, repositoryFormat.HasHttpAuthentication(detached))
+	assert.False(t, (&RawRepositoryFormatHosted{}).HasHttpAuthentication(model.RepositoryRawHostedModel{}))
+}
@@ -122,6 +122,12 @@ func (f *RubyGemsRepositoryFormatHosted) UpdateStateFromApi(state any, api any)
 	return stateModel
 }
 
+func (f *RubyGemsRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryRubyGemsHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for RubyGems Hosted repositories
 func (f *RubyGemsRepositoryFormatHosted) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
@@ -233,6 +239,23 @@ func (f *RubyGemsRepositoryFormatProxy) UpdateStateFromApi(state any, api any) a
 	return stateModel
 }
 
+func (f *RubyGemsRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositorRubyGemsProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *RubyGemsRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositorRubyGemsProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *RubyGemsRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositorRubyGemsProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *RubyGemsRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositorRubyGemsProxyModel)
 	var stateModel model.RepositorRubyGemsProxyModel
@@ -363,6 +386,17 @@ func (f *RubyGemsRepositoryFormatGroup) UpdateStateFromApi(state any, api any) a
 	return stateModel
 }
 
+func (f *RubyGemsRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositoryRubyGemsGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, types.StringNull())
+}
+
+func (f *RubyGemsRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositoryRubyGemsGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for RubyGems Group repositories
 func (f *RubyGemsRepositoryFormatGroup) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
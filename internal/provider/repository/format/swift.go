@@ -132,6 +132,23 @@ func (f *SwiftRepositoryFormatProxy) UpdateStateFromApi(state, api any) any {
 	return stateModel
 }
 
+func (f *SwiftRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositorySwiftProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *SwiftRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositorySwiftProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *SwiftRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositorySwiftProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *SwiftRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositorySwiftProxyModel)
 	var stateModel model.RepositorySwiftProxyModel
@@ -212,6 +229,17 @@ func (f *SwiftRepositoryFormatGroup) UpdateStateFromApi(state any, api any) any
 	return stateModel
 }
 
+func (f *SwiftRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositorySwiftGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, types.StringNull())
+}
+
+func (f *SwiftRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositorySwiftGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
+
 // DoImportRequest implements the import functionality for Swift Group repositories
 func (f *SwiftRepositoryFormatGroup) DoImportRequest(repositoryName string, apiClient common.RepositoryManagementService, ctx context.Context) (any, *http.Response, error) {
 	// Call to API to Read repository for import
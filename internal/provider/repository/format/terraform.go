@@ -137,6 +137,23 @@ func (f *TerraformRepositoryFormatProxy) UpdateStateFromApi(state, api any) any
 	return stateModel
 }
 
+func (f *TerraformRepositoryFormatProxy) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryTerraformProxyModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
+func (f *TerraformRepositoryFormatProxy) DetachRoutingRule(state any) any {
+	var stateModel = (state).(model.RepositoryTerraformProxyModel)
+	stateModel.DetachRoutingRule()
+	return stateModel
+}
+
+func (f *TerraformRepositoryFormatProxy) HasHttpAuthentication(state any) bool {
+	var stateModel = (state).(model.RepositoryTerraformProxyModel)
+	return stateModel.HasHttpAuthentication()
+}
+
 func (f *TerraformRepositoryFormatProxy) UpdateStateFromPlanForNonApiFields(plan, state any) any {
 	var planModel = (plan).(model.RepositoryTerraformProxyModel)
 	var stateModel model.RepositoryTerraformProxyModel
@@ -227,6 +244,12 @@ func (f *TerraformRepositoryFormatHosted) UpdateStateFromApi(state, api any) any
 	return stateModel
 }
 
+func (f *TerraformRepositoryFormatHosted) DetachCleanupPolicy(state any, cleanupPolicyName string) any {
+	var stateModel = (state).(model.RepositoryTerraformHostedModel)
+	stateModel.DetachCleanupPolicy(cleanupPolicyName)
+	return stateModel
+}
+
 // --------------------------------------------
 // GROUP Terraform Format Functions
 // --------------------------------------------
@@ -303,6 +326,17 @@ func (f *TerraformRepositoryFormatGroup) UpdateStateFromApi(state any, api any)
 	return stateModel
 }
 
+func (f *TerraformRepositoryFormatGroup) GroupMembershipFromPlan(plan any) *GroupMembership {
+	var planModel = (plan).(model.RepositoryTerraformGroupModel)
+	return groupMembershipFromModel(planModel.Name, planModel.Group.MemberNames, types.StringNull())
+}
+
+func (f *TerraformRepositoryFormatGroup) UpdateGroupMemberNames(state any, memberNames []string) any {
+	var stateModel = (state).(model.RepositoryTerraformGroupModel)
+	stateModel.Group.MemberNames = groupMemberNamesAsModel(memberNames)
+	return stateModel
+}
+
 func (f *TerraformRepositoryFormatGroup) AdditionalSchemaDescription() string {
 	return `
 
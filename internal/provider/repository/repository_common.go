@@ -26,6 +26,7 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -52,6 +53,9 @@ const (
 	REPOSITORY_ERROR_DID_NOT_EXIST             = "%s %s Repository did not exist to %s"
 )
 
+// Ensure the implementation satisfies the expected interfaces.
+var _ resource.ResourceWithModifyPlan = &repositoryResource{}
+
 // Generic to all Repository Resources
 type repositoryResource struct {
 	common.BaseResource
@@ -345,6 +349,91 @@ func (r *repositoryResource) configureFirewall(ctx context.Context, plan interfa
 	return stateModel
 }
 
+// ModifyPlan validates the plan against the Repositories that already exist, so that invalid Group
+// Repository membership and colliding Docker connectors are reported at plan time rather than as an
+// unhelpful error from Sonatype Nexus Repository during apply.
+func (r *repositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to validate when destroying, or before the Provider has been configured
+	if req.Plan.Raw.IsNull() || !r.IsConfigured() {
+		return
+	}
+
+	// Plans containing values not yet known cannot be decoded - they are validated by
+	// Sonatype Nexus Repository during apply instead
+	plan, diags := r.RepositoryFormat.PlanAsModel(ctx, req.Plan)
+	if diags.HasError() {
+		tflog.Debug(ctx, "Skipping Repository plan validation as plan contains unknown values")
+		return
+	}
+
+	ctx = r.AuthContext(ctx)
+
+	if membership := r.RepositoryFormat.GroupMembershipFromPlan(plan); membership != nil {
+		r.validateGroupMembership(ctx, membership, &resp.Diagnostics)
+	}
+
+	if connector := r.RepositoryFormat.DockerConnectorFromPlan(plan); connector != nil && !r.dockerConnectorInState(ctx, req.State, connector) {
+		r.validateDockerConnector(ctx, connector, &resp.Diagnostics)
+	}
+}
+
+// dockerConnectorInState is true if the planned connector is unchanged from state - reading every Docker
+// Repository to check for conflicts is only needed on create, or when the ports or subdomain change.
+func (r *repositoryResource) dockerConnectorInState(ctx context.Context, state tfsdk.State, planned *format.DockerConnector) bool {
+	if state.Raw.IsNull() {
+		return false
+	}
+	stateModel, diags := r.RepositoryFormat.StateAsModel(ctx, state)
+	if diags.HasError() {
+		return false
+	}
+	return planned.Equal(r.RepositoryFormat.DockerConnectorFromPlan(stateModel))
+}
+
+// validateGroupMembership reports cycles, format mismatches and invalid writable members
+func (r *repositoryResource) validateGroupMembership(ctx context.Context, membership *format.GroupMembership, respDiags *diag.Diagnostics) {
+	messages, httpResponse, err := format.ValidateGroupMembership(ctx, r.Services.Repository, r.RepositoryFormat.Key(), membership)
+	if err != nil {
+		errors.HandleAPIWarning(
+			"Unable to validate Group Repository membership",
+			&err,
+			httpResponse,
+			respDiags,
+		)
+		return
+	}
+
+	for _, m := range messages {
+		respDiags.AddAttributeError(
+			path.Root("group"),
+			fmt.Sprintf("Invalid membership for %s Group Repository '%s'", r.RepositoryFormat.Key(), membership.Name),
+			m,
+		)
+	}
+}
+
+// validateDockerConnector reports ports and subdomains already allocated to other Docker Repositories
+func (r *repositoryResource) validateDockerConnector(ctx context.Context, connector *format.DockerConnector, respDiags *diag.Diagnostics) {
+	conflicts, httpResponse, err := format.ValidateDockerConnector(ctx, r.Services.Repository, connector)
+	if err != nil {
+		errors.HandleAPIWarning(
+			"Unable to validate Docker Repository connectors",
+			&err,
+			httpResponse,
+			respDiags,
+		)
+		return
+	}
+
+	for _, c := range conflicts {
+		respDiags.AddAttributeError(
+			path.Root("docker").AtName(c.Attribute),
+			fmt.Sprintf("Conflicting connector for Docker Repository '%s'", connector.RepositoryName),
+			c.Message,
+		)
+	}
+}
+
 func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Retrieve values from state
 	stateModel, diags := r.RepositoryFormat.StateAsModel(ctx, req.State)
@@ -0,0 +1,363 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/validator"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"terraform-provider-sonatyperepo/internal/provider/repository/format"
+
+	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
+
+	"github.com/sonatype-nexus-community/terraform-provider-shared/errors"
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+const (
+	// groupMemberMaxAttempts is how many times membership is re-read and re-written when a
+	// concurrent update to the same Group Repository causes our change to be lost
+	groupMemberMaxAttempts   = 5
+	groupMemberRetryInterval = 1 * time.Second
+
+	errGroupMemberGroupNotFound     = "Group Repository '%s' does not exist"
+	errGroupMemberNotAGroup         = "Repository '%s' is a %s Repository, not a Group Repository"
+	errGroupMemberUnsupportedFormat = "Group Repository '%s' has format '%s' which is not supported"
+	errGroupMemberConflict          = "membership of Group Repository '%s' was modified concurrently on each of %d attempts"
+)
+
+// groupMemberLocks serialises membership changes to the same Group Repository from within this
+// provider instance - changes made from elsewhere are caught by re-reading after each write.
+var groupMemberLocks sync.Map
+
+// repositoryGroupMemberResource is the resource implementation.
+type repositoryGroupMemberResource struct {
+	common.BaseResource
+}
+
+// NewRepositoryGroupMemberResource is a helper function to simplify the provider implementation.
+func NewRepositoryGroupMemberResource() resource.Resource {
+	return &repositoryGroupMemberResource{}
+}
+
+// Metadata returns the resource type name.
+func (r *repositoryGroupMemberResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_group_member"
+}
+
+// Schema defines the schema for the resource.
+func (r *repositoryGroupMemberResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = tfschema.Schema{
+		Description: `Use this resource to add a single member to a Group Repository of any format, without managing the other members.
+
+This resource is non-authoritative - other members of the Group Repository (whether added by other instances of this resource,
+or directly) are left untouched.
+
+**NOTE:** Do not use this resource together with ` + "`group.member_names`" + ` on a ` + "`sonatyperepo_repository_*_group`" + ` resource
+for the same Group Repository, as they will conflict.`,
+		Attributes: map[string]tfschema.Attribute{
+			"group_name": schema.ResourceRequiredStringWithPlanModifier(
+				"Name of the Group Repository to add the member to",
+				[]planmodifier.String{stringplanmodifier.RequiresReplace()},
+			),
+			"member_name": schema.ResourceRequiredStringWithPlanModifier(
+				"Name of the Repository to add as a member - must be of the same format as the Group Repository",
+				[]planmodifier.String{stringplanmodifier.RequiresReplace()},
+			),
+			"position": func() tfschema.Int64Attribute {
+				attr := schema.ResourceOptionalInt64(
+					"Zero-based position of the member within the Group Repository - if not set, the member is appended to the end. " +
+						"Positions beyond the end of the Group Repository place the member last.",
+				)
+				attr.Validators = []validator.Int64{
+					int64validator.AtLeast(0),
+				}
+				return attr
+			}(),
+			"last_updated": schema.ResourceLastUpdated(),
+		},
+	}
+}
+
+// Create adds the member to the Group Repository and sets the initial Terraform state.
+func (r *repositoryGroupMemberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan model.RepositoryGroupMemberModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	ctx = r.AuthContext(ctx)
+
+	httpResponse, err := r.modifyGroupMembers(ctx, plan.GroupName.ValueString(), func(memberNames []string) ([]string, bool) {
+		return format.GroupMemberNamesWith(memberNames, plan.MemberName.ValueString(), plan.Position.ValueInt64Pointer())
+	})
+	if err != nil {
+		errors.HandleAPIError(
+			"Error adding member to Group Repository",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *repositoryGroupMemberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state model.RepositoryGroupMemberModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	ctx = r.AuthContext(ctx)
+
+	group, httpResponse, err := r.readGroupRepository(ctx, state.GroupName.ValueString())
+	if err != nil {
+		errors.HandleAPIError(
+			"Error reading Group Repository",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+	if group == nil {
+		tflog.Warn(ctx, fmt.Sprintf(errGroupMemberGroupNotFound, state.GroupName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	position := slices.Index(group.memberNames, state.MemberName.ValueString())
+	if position < 0 {
+		tflog.Warn(ctx, fmt.Sprintf("Repository '%s' is no longer a member of Group Repository '%s'", state.MemberName.ValueString(), state.GroupName.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Only track position if it is being managed - and a position beyond the end is satisfied by being last
+	if _, moved := format.GroupMemberNamesWith(group.memberNames, state.MemberName.ValueString(), state.Position.ValueInt64Pointer()); moved {
+		state.Position = types.Int64Value(int64(position))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update moves the member within the Group Repository - all other changes force replacement.
+func (r *repositoryGroupMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan model.RepositoryGroupMemberModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting plan data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	ctx = r.AuthContext(ctx)
+
+	httpResponse, err := r.modifyGroupMembers(ctx, plan.GroupName.ValueString(), func(memberNames []string) ([]string, bool) {
+		return format.GroupMemberNamesWith(memberNames, plan.MemberName.ValueString(), plan.Position.ValueInt64Pointer())
+	})
+	if err != nil {
+		errors.HandleAPIError(
+			"Error updating member of Group Repository",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the member from the Group Repository and removes the Terraform state on success.
+func (r *repositoryGroupMemberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state model.RepositoryGroupMemberModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Getting state data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
+	ctx = r.AuthContext(ctx)
+
+	group, httpResponse, err := r.readGroupRepository(ctx, state.GroupName.ValueString())
+	if err == nil && group == nil {
+		// Group Repository already deleted, nothing to do
+		tflog.Warn(ctx, fmt.Sprintf(errGroupMemberGroupNotFound, state.GroupName.ValueString()))
+		return
+	}
+
+	if err == nil {
+		httpResponse, err = r.modifyGroupMembers(ctx, state.GroupName.ValueString(), func(memberNames []string) ([]string, bool) {
+			return format.GroupMemberNamesWithout(memberNames, state.MemberName.ValueString())
+		})
+	}
+	if err != nil {
+		errors.HandleAPIError(
+			"Error removing member from Group Repository",
+			&err,
+			httpResponse,
+			&resp.Diagnostics,
+		)
+	}
+}
+
+// ImportState imports the resource by Group Repository and member name.
+func (r *repositoryGroupMemberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: <group_name>,<member_name> - e.g. maven-public,maven-releases. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_name"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("member_name"), idParts[1])...)
+}
+
+// groupRepository is a Group Repository as read from Sonatype Nexus Repository, along with the
+// Repository Format that is used to read and update it.
+type groupRepository struct {
+	format      format.RepositoryFormat
+	state       any
+	memberNames []string
+}
+
+// readGroupRepository reads the named Group Repository whatever its format - returning nil if it does not exist.
+func (r *repositoryGroupMemberResource) readGroupRepository(ctx context.Context, groupName string) (*groupRepository, *http.Response, error) {
+	repositories, httpResponse, err := r.Services.Repository.ListRepositories(ctx)
+	if err != nil {
+		return nil, httpResponse, err
+	}
+
+	idx := slices.IndexFunc(repositories, func(repository sonatyperepo.RepositoryXO) bool {
+		return repository.GetName() == groupName
+	})
+	if idx < 0 {
+		return nil, httpResponse, nil
+	}
+	repository := repositories[idx]
+
+	if repository.GetType() != format.REPO_TYPE_GROUP.String() {
+		return nil, nil, fmt.Errorf(errGroupMemberNotAGroup, groupName, repository.GetType())
+	}
+
+	groupFormat := format.GroupRepositoryFormatFor(repository.GetFormat())
+	if groupFormat == nil {
+		return nil, nil, fmt.Errorf(errGroupMemberUnsupportedFormat, groupName, repository.GetFormat())
+	}
+
+	apiResponse, httpResponse, err := groupFormat.DoImportRequest(groupName, r.Services.Repository, ctx)
+	if err != nil {
+		if httpResponse != nil && httpResponse.StatusCode == http.StatusNotFound {
+			return nil, httpResponse, nil
+		}
+		return nil, httpResponse, err
+	}
+
+	state := groupFormat.UpdateStateFromApi(nil, apiResponse)
+	return &groupRepository{
+		format:      groupFormat,
+		state:       state,
+		memberNames: groupFormat.GroupMembershipFromPlan(state).MemberNames,
+	}, httpResponse, nil
+}
+
+// modifyGroupMembers reads the Group Repository, applies modify to its members and writes the result back
+// using the update call for the Group Repository's format.
+//
+// Sonatype Nexus Repository offers no way to make a conditional update, so after each write the Group
+// Repository is read again - if a concurrent update means our change has been lost, it is re-applied on top
+// of the latest membership.
+func (r *repositoryGroupMemberResource) modifyGroupMembers(ctx context.Context, groupName string, modify func(memberNames []string) ([]string, bool)) (*http.Response, error) {
+	lock, _ := groupMemberLocks.LoadOrStore(groupName, &sync.Mutex{})
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+
+	for attempt := 0; ; attempt++ {
+		group, httpResponse, err := r.readGroupRepository(ctx, groupName)
+		if err != nil {
+			return httpResponse, err
+		}
+		if group == nil {
+			return nil, fmt.Errorf(errGroupMemberGroupNotFound, groupName)
+		}
+
+		memberNames, changed := modify(group.memberNames)
+		if !changed {
+			return httpResponse, nil
+		}
+
+		if attempt == groupMemberMaxAttempts {
+			return nil, fmt.Errorf(errGroupMemberConflict, groupName, groupMemberMaxAttempts)
+		}
+		if attempt > 0 {
+			tflog.Info(ctx, fmt.Sprintf("Membership of Group Repository '%s' changed concurrently, retrying (%d/%d)", groupName, attempt, groupMemberMaxAttempts))
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(time.Duration(attempt) * groupMemberRetryInterval):
+			}
+		}
+
+		tflog.Debug(ctx, fmt.Sprintf("Updating members of Group Repository '%s' to %v", groupName, memberNames))
+		httpResponse, err = group.format.DoUpdateRequest(
+			group.format.UpdateGroupMemberNames(group.state, memberNames),
+			group.state,
+			r.Services.Repository,
+			ctx,
+		)
+		if err != nil && (httpResponse == nil || httpResponse.StatusCode != http.StatusConflict) {
+			return httpResponse, err
+		}
+	}
+}
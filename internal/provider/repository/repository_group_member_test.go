@@ -0,0 +1,173 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package repository_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	utils_test "terraform-provider-sonatyperepo/internal/provider/utils"
+)
+
+const (
+	resourceTypeRepositoryGroupMember = "sonatyperepo_repository_group_member"
+)
+
+func TestAccRepositoryGroupMemberResource(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	firstMemberResourceName := fmt.Sprintf("%s.first", resourceTypeRepositoryGroupMember)
+	secondMemberResourceName := fmt.Sprintf("%s.second", resourceTypeRepositoryGroupMember)
+	groupName := fmt.Sprintf("raw-group-member-%s", randomString)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: getTestAccRepositoryGroupMemberResourceConfig(randomString, 0),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(firstMemberResourceName, "group_name", groupName),
+					resource.TestCheckResourceAttr(firstMemberResourceName, "member_name", fmt.Sprintf("raw-hosted-member-a-%s", randomString)),
+					resource.TestCheckNoResourceAttr(firstMemberResourceName, "position"),
+					resource.TestCheckResourceAttr(secondMemberResourceName, "group_name", groupName),
+					resource.TestCheckResourceAttr(secondMemberResourceName, "member_name", fmt.Sprintf("raw-hosted-member-b-%s", randomString)),
+					resource.TestCheckResourceAttr(secondMemberResourceName, "position", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:                         secondMemberResourceName,
+				ImportState:                          true,
+				ImportStateId:                        fmt.Sprintf("%s,raw-hosted-member-b-%s", groupName, randomString),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "member_name",
+				ImportStateVerifyIgnore:              []string{"last_updated", "position"},
+			},
+			// Update (move) and Read testing
+			{
+				Config: getTestAccRepositoryGroupMemberResourceConfig(randomString, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(secondMemberResourceName, "position", "1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccRepositoryGroupMemberResourceInvalidImportId(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: getTestAccRepositoryGroupMemberResourceConfig(randomString, 0),
+			},
+			{
+				ResourceName:  fmt.Sprintf("%s.second", resourceTypeRepositoryGroupMember),
+				ImportState:   true,
+				ImportStateId: "raw-group-only",
+				ExpectError:   regexp.MustCompile("Unexpected Import Identifier"),
+			},
+		},
+	})
+}
+
+func TestAccRepositoryGroupMemberResourceNotAGroup(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatyperepo_repository_raw_hosted" "hosted" {
+  name = "raw-hosted-not-group-%s"
+  online = true
+  storage = {
+    blob_store_name = "default"
+    strict_content_type_validation = true
+    write_policy = "ALLOW"
+  }
+}
+
+resource "%s" "member" {
+  group_name  = sonatyperepo_repository_raw_hosted.hosted.name
+  member_name = "does-not-matter"
+}
+`, randomString, resourceTypeRepositoryGroupMember),
+				ExpectError: regexp.MustCompile("is a hosted Repository, not a Group Repository"),
+			},
+		},
+	})
+}
+
+func getTestAccRepositoryGroupMemberResourceConfig(randomString string, secondPosition int) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatyperepo_repository_raw_hosted" "a" {
+  name = "raw-hosted-member-a-%[1]s"
+  online = true
+  storage = {
+    blob_store_name = "default"
+    strict_content_type_validation = true
+    write_policy = "ALLOW"
+  }
+}
+
+resource "sonatyperepo_repository_raw_hosted" "b" {
+  name = "raw-hosted-member-b-%[1]s"
+  online = true
+  storage = {
+    blob_store_name = "default"
+    strict_content_type_validation = true
+    write_policy = "ALLOW"
+  }
+}
+
+resource "sonatyperepo_repository_raw_group" "group" {
+  name = "raw-group-member-%[1]s"
+  online = true
+  storage = {
+    blob_store_name = "default"
+    strict_content_type_validation = true
+  }
+  group = {}
+
+  lifecycle {
+    ignore_changes = [group]
+  }
+}
+
+resource "%[2]s" "first" {
+  group_name  = sonatyperepo_repository_raw_group.group.name
+  member_name = sonatyperepo_repository_raw_hosted.a.name
+}
+
+resource "%[2]s" "second" {
+  group_name  = sonatyperepo_repository_raw_group.group.name
+  member_name = sonatyperepo_repository_raw_hosted.b.name
+  position    = %[3]d
+
+  depends_on = [%[2]s.first]
+}
+`, randomString, resourceTypeRepositoryGroupMember, secondPosition)
+}
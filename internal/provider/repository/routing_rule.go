@@ -33,6 +33,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/dependency"
 	"terraform-provider-sonatyperepo/internal/provider/model"
 
 	sonatyperepo "github.com/sonatype-nexus-community/nexus-repo-api-client-go/v3"
@@ -92,6 +93,10 @@ func (r *routingRuleResource) Schema(_ context.Context, _ resource.SchemaRequest
 				"Regular expressions used to identify request paths that are allowed or blocked (depending on mode)",
 				setvalidator.SizeAtLeast(1),
 			),
+			"force_detach": schema.ResourceOptionalBoolWithDefault(
+				"When destroying, remove this routing rule from any Repositories it is applied to first - otherwise deletion fails while it is in use",
+				false,
+			),
 			"last_updated": schema.ResourceLastUpdated(),
 		},
 	}
@@ -102,7 +107,7 @@ func (r *routingRuleResource) Create(ctx context.Context, req resource.CreateReq
 	// Retrieve values from plan
 	var plan model.RoutingRuleModel
 
-	resp.Diagnostics.Append(req.Config.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 
 	if resp.Diagnostics.HasError() {
 		tflog.Error(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
@@ -256,6 +261,10 @@ func (r *routingRuleResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	ctx = r.AuthContext(ctx)
 
+	if !dependency.GuardDelete(ctx, r.Services, dependency.KIND_ROUTING_RULE, state.Name.ValueString(), state.ForceDetach.ValueBool(), &resp.Diagnostics) {
+		return
+	}
+
 	// Delete API Call
 	apiResponse, err := r.Services.RoutingRule.DeleteRoutingRule(ctx, state.Name.ValueString())
 
@@ -295,4 +304,5 @@ func (r *routingRuleResource) Delete(ctx context.Context, req resource.DeleteReq
 func (r *routingRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to name attribute
 	resource.ImportStatePassthroughID(ctx, path.Root("name"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("force_detach"), false)...)
 }
@@ -85,13 +85,14 @@ func TestAccRoutingRuleResourceMinimal(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
 		Steps: []resource.TestStep{
-			// Create and Read testing with minimal configuration
+			// Create and Read testing with minimal configuration - force_detach is not set, so its default is planned
 			{
 				Config: getTestAccRoutingRuleResourceMinimalConfig(randomString),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("minimal-routing-rule-%s", randomString)),
 					resource.TestCheckResourceAttr(resourceName, "mode", repository.RoutingRuleModeBlock),
 					resource.TestCheckResourceAttr(resourceName, matchersCount, "1"),
+					resource.TestCheckResourceAttr(resourceName, "force_detach", "false"),
 				),
 			},
 			// Delete testing automatically occurs in TestCase
@@ -28,3 +28,31 @@ func NewTaskBlobstoreCompactResource() resource.Resource {
 		TaskType: tasktype.NewBlobstoreCompactTask(),
 	}
 }
+
+// NewTaskBlobstoreDeleteTempFilesResource is a helper function to simplify the provider implementation.
+func NewTaskBlobstoreDeleteTempFilesResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewBlobstoreDeleteTempFilesTask(),
+	}
+}
+
+// NewTaskBlobstoreExecuteReconciliationPlanResource is a helper function to simplify the provider implementation.
+func NewTaskBlobstoreExecuteReconciliationPlanResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewBlobstoreExecuteReconciliationPlanTask(),
+	}
+}
+
+// NewTaskBlobstoreMetricsReconcileResource is a helper function to simplify the provider implementation.
+func NewTaskBlobstoreMetricsReconcileResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewBlobstoreMetricsReconcileTask(),
+	}
+}
+
+// NewTaskBlobstorePlanReconciliationResource is a helper function to simplify the provider implementation.
+func NewTaskBlobstorePlanReconciliationResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewBlobstorePlanReconciliationTask(),
+	}
+}
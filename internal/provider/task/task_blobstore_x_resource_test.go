@@ -18,6 +18,7 @@ package task_test
 
 import (
 	"fmt"
+	"regexp"
 	"terraform-provider-sonatyperepo/internal/provider/common"
 	utils_test "terraform-provider-sonatyperepo/internal/provider/utils"
 	"testing"
@@ -63,23 +64,198 @@ resource "%s" "test_task" {
 					resource.TestCheckResourceAttr(resourceNameTaskBlobstoreCompact, "properties.blobs_older_than", "8"),
 				),
 			},
-			// Import testing - the public REST API does not return `properties` or
-			// full `frequency`, so those attributes plus `last_updated` cannot be
-			// verified against the imported state.
+			// Import testing - an empty `alert_email` is imported as null, and Sonatype
+			// Nexus Repository fills in `frequency` defaults that are not configured
+			// here, so those attributes plus `last_updated` cannot be verified against
+			// the imported state.
 			{
 				ResourceName:      resourceNameTaskBlobstoreCompact,
 				ImportState:       true,
 				ImportStateVerify: true,
 				ImportStateVerifyIgnore: []string{
 					"alert_email",
-					"enabled",
 					"frequency",
 					"last_updated",
-					"notification_condition",
-					"properties",
 				},
 			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
 }
+
+func TestAccTaskBlobstoreDeleteTempFilesResource(t *testing.T) {
+
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceType := "sonatyperepo_task_blobstore_delete_temp_files"
+	resourceName := fmt.Sprintf(resourceNameF, resourceType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test_task" {
+  name = "test-blobstore-delete-temp-files-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    blob_store_name = "default"
+  }
+}
+`, resourceType, randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("test-blobstore-delete-temp-files-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, fieldFrequencySchedule, common.FREQUENCY_SCHEDULE_MANUAL),
+					resource.TestCheckResourceAttr(resourceName, "properties.blob_store_name", "default"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTaskBlobstoreMetricsReconcileResource(t *testing.T) {
+
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceType := "sonatyperepo_task_blobstore_metrics_reconcile"
+	resourceName := fmt.Sprintf(resourceNameF, resourceType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test_task" {
+  name = "test-blobstore-metrics-reconcile-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    blob_store_name = "default"
+  }
+}
+`, resourceType, randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("test-blobstore-metrics-reconcile-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, fieldFrequencySchedule, common.FREQUENCY_SCHEDULE_MANUAL),
+					resource.TestCheckResourceAttr(resourceName, "properties.blob_store_name", "default"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTaskBlobstorePlanReconciliationResource(t *testing.T) {
+
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceType := "sonatyperepo_task_blobstore_planreconciliation"
+	resourceName := fmt.Sprintf(resourceNameF, resourceType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Since days cannot be combined with a date range
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test_task" {
+  name = "test-blobstore-plan-reconciliation-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    blob_store_name = "default"
+    since_days = 7
+    start_date = "2026-01-01"
+    end_date = "2026-01-31"
+  }
+}
+`, resourceType, randomString),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test_task" {
+  name = "test-blobstore-plan-reconciliation-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    blob_store_name = "default"
+    since_days = 7
+  }
+}
+`, resourceType, randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("test-blobstore-plan-reconciliation-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, fieldFrequencySchedule, common.FREQUENCY_SCHEDULE_MANUAL),
+					resource.TestCheckResourceAttr(resourceName, "properties.blob_store_name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "properties.since_days", "7"),
+					resource.TestCheckNoResourceAttr(resourceName, "properties.start_date"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTaskBlobstoreExecuteReconciliationPlanResource(t *testing.T) {
+
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceType := "sonatyperepo_task_blobstore_executereconciliationplan"
+	resourceName := fmt.Sprintf(resourceNameF, resourceType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test_task" {
+  name = "test-blobstore-execute-reconciliation-plan-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    blob_store_name = "default"
+  }
+}
+`, resourceType, randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("test-blobstore-execute-reconciliation-plan-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, fieldFrequencySchedule, common.FREQUENCY_SCHEDULE_MANUAL),
+					resource.TestCheckResourceAttr(resourceName, "properties.blob_store_name", "default"),
+					resource.TestCheckNoResourceAttr(resourceName, "properties.plan_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
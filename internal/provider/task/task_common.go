@@ -21,16 +21,24 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"regexp"
 	"slices"
 	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
 	tasktype "terraform-provider-sonatyperepo/internal/provider/task/task_type"
+	"terraform-provider-sonatyperepo/internal/provider/validators"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -45,6 +53,9 @@ const (
 	TASK_ERROR_DID_NOT_EXIST             = "%s Task did not exist to %s"
 )
 
+// Ensure the implementation satisfies the expected interfaces.
+var _ resource.ResourceWithModifyPlan = &taskResource{}
+
 // Generic to all Task Resources
 type taskResource struct {
 	common.BaseResource
@@ -67,9 +78,77 @@ func (r *taskResource) ImportState(ctx context.Context, req resource.ImportState
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
 
+// ModifyPlan converts frequency.timezone into the timezone_offset for the next run of a Task - planning
+// a change when that offset changes - and calculates next_fire_times when the Frequency of a Task is
+// planned to change, so that the times a Task will run can be reviewed before apply. Otherwise the times
+// already in state are kept.
+//
+// timezone_offset is only computed from timezone - when neither is configured it is planned as null, so
+// that removing timezone_offset from configuration clears it.
+func (t *taskResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to calculate when destroying
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var configTimezoneOffset, configTimezone types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("frequency").AtName("timezone_offset"), &configTimezoneOffset)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("frequency").AtName("timezone"), &configTimezone)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if configTimezoneOffset.IsNull() && configTimezone.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("frequency").AtName("timezone_offset"), types.StringNull())...)
+	}
+
+	var planFrequency types.Object
+	resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("frequency"), &planFrequency)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	timezoneOffset, diags := plannedTimezoneOffsetFor(ctx, planFrequency)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !timezoneOffset.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("frequency").AtName("timezone_offset"), timezoneOffset)...)
+		resp.Diagnostics.Append(resp.Plan.GetAttribute(ctx, path.Root("frequency"), &planFrequency)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !req.State.Raw.IsNull() {
+		var stateFrequency types.Object
+		var stateNextFireTimes types.List
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("frequency"), &stateFrequency)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("next_fire_times"), &stateNextFireTimes)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if planFrequency.Equal(stateFrequency) && !stateNextFireTimes.IsNull() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_fire_times"), stateNextFireTimes)...)
+			return
+		}
+	}
+
+	nextFireTimes, diags := nextFireTimesFor(ctx, planFrequency)
+	resp.Diagnostics.Append(diags...)
+	if nextFireTimes.IsUnknown() || resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("next_fire_times"), nextFireTimes)...)
+}
+
 func (t *taskResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// timezone_offset could not be calculated when planned if the timezone was not yet known
+	planned := req.Plan
+	resp.Diagnostics.Append(resolveUnknownTimezoneOffset(ctx, &planned)...)
+
 	// Retrieve values from plan
-	plan, diags := t.TaskType.PlanAsModel(ctx, req.Plan)
+	plan, diags := t.TaskType.PlanAsModel(ctx, planned)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
@@ -108,6 +187,9 @@ func (t *taskResource) Create(ctx context.Context, req resource.CreateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// next_fire_times could not be calculated when planned if the Frequency was not yet known
+	resp.Diagnostics.Append(refreshUnknownNextFireTimes(ctx, &resp.State)...)
 }
 
 func (t *taskResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -157,16 +239,24 @@ func (t *taskResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	// The public REST API only returns id/name/type/schedule for a Task; nested
-	// `properties` and `frequency` cannot be refreshed here, so drift on those
-	// fields is not detected. Apply re-asserts the configured values.
+	// Refresh the full Task configuration (including `frequency` and `properties`) so that
+	// changes made outside Terraform are shown as drift
 	stateModel = t.TaskType.UpdateStateFromApi(stateModel, *apiResponse)
 	resp.Diagnostics.Append(resp.State.Set(ctx, stateModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(refreshNextFireTimes(ctx, &resp.State)...)
 }
 
 func (t *taskResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// timezone_offset could not be calculated when planned if the timezone was not yet known
+	planned := req.Plan
+	resp.Diagnostics.Append(resolveUnknownTimezoneOffset(ctx, &planned)...)
+
 	// Retrieve values from plan
-	planModel, diags := t.TaskType.PlanAsModel(ctx, req.Plan)
+	planModel, diags := t.TaskType.PlanAsModel(ctx, planned)
 	resp.Diagnostics.Append(diags...)
 
 	// Retrieve values from state
@@ -211,6 +301,9 @@ func (t *taskResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	// next_fire_times could not be calculated when planned if the Frequency was not yet known
+	resp.Diagnostics.Append(refreshUnknownNextFireTimes(ctx, &resp.State)...)
 }
 
 func (t *taskResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -287,6 +380,94 @@ func (t *taskResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 }
 
+// plannedTimezoneOffsetFor returns the timezone_offset to plan for the given Frequency - unknown if the
+// Frequency is not yet known.
+func plannedTimezoneOffsetFor(ctx context.Context, frequencyObject types.Object) (types.String, diag.Diagnostics) {
+	if frequencyObject.IsNull() || frequencyObject.IsUnknown() {
+		return types.StringUnknown(), nil
+	}
+	for name, v := range frequencyObject.Attributes() {
+		if v.IsUnknown() && name != "timezone_offset" {
+			return types.StringUnknown(), nil
+		}
+	}
+
+	var frequency model.TaskFrequency
+	diags := frequencyObject.As(ctx, &frequency, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return types.StringUnknown(), diags
+	}
+	return frequency.PlannedTimezoneOffset(time.Now()), diags
+}
+
+// resolveUnknownTimezoneOffset calculates frequency.timezone_offset in the plan, only if it is not yet known.
+func resolveUnknownTimezoneOffset(ctx context.Context, plan *tfsdk.Plan) diag.Diagnostics {
+	var timezoneOffset types.String
+	diags := plan.GetAttribute(ctx, path.Root("frequency").AtName("timezone_offset"), &timezoneOffset)
+	if diags.HasError() || !timezoneOffset.IsUnknown() {
+		return diags
+	}
+	var frequency types.Object
+	diags.Append(plan.GetAttribute(ctx, path.Root("frequency"), &frequency)...)
+	if diags.HasError() {
+		return diags
+	}
+	timezoneOffset, d := plannedTimezoneOffsetFor(ctx, frequency)
+	diags.Append(d...)
+	if timezoneOffset.IsUnknown() || diags.HasError() {
+		return diags
+	}
+	diags.Append(plan.SetAttribute(ctx, path.Root("frequency").AtName("timezone_offset"), timezoneOffset)...)
+	return diags
+}
+
+// nextFireTimesFor calculates next_fire_times for the given Frequency - unknown if the Frequency is not
+// yet known.
+func nextFireTimesFor(ctx context.Context, frequencyObject types.Object) (types.List, diag.Diagnostics) {
+	if frequencyObject.IsNull() || frequencyObject.IsUnknown() {
+		return types.ListUnknown(types.StringType), nil
+	}
+	for _, v := range frequencyObject.Attributes() {
+		if v.IsUnknown() {
+			return types.ListUnknown(types.StringType), nil
+		}
+	}
+
+	var frequency model.TaskFrequency
+	diags := frequencyObject.As(ctx, &frequency, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return types.ListUnknown(types.StringType), diags
+	}
+	return frequency.NextFireTimesValue(time.Now(), common.TASK_NEXT_FIRE_TIMES_COUNT), diags
+}
+
+// refreshUnknownNextFireTimes calculates next_fire_times in state, only if they are not yet known.
+func refreshUnknownNextFireTimes(ctx context.Context, state *tfsdk.State) diag.Diagnostics {
+	var nextFireTimes types.List
+	diags := state.GetAttribute(ctx, path.Root("next_fire_times"), &nextFireTimes)
+	if diags.HasError() || !nextFireTimes.IsUnknown() {
+		return diags
+	}
+	diags.Append(refreshNextFireTimes(ctx, state)...)
+	return diags
+}
+
+// refreshNextFireTimes recalculates next_fire_times in state, from the Frequency in state.
+func refreshNextFireTimes(ctx context.Context, state *tfsdk.State) diag.Diagnostics {
+	var frequency types.Object
+	diags := state.GetAttribute(ctx, path.Root("frequency"), &frequency)
+	if diags.HasError() {
+		return diags
+	}
+	nextFireTimes, d := nextFireTimesFor(ctx, frequency)
+	diags.Append(d...)
+	if nextFireTimes.IsUnknown() || diags.HasError() {
+		return diags
+	}
+	diags.Append(state.SetAttribute(ctx, path.Root("next_fire_times"), nextFireTimes)...)
+	return diags
+}
+
 func taskSchema(tt tasktype.TaskTypeI) tfschema.Schema {
 	attributes := map[string]tfschema.Attribute{
 		"id":          schema.ResourceComputedString("The internal ID of the Task."),
@@ -298,36 +479,65 @@ func taskSchema(tt tasktype.TaskTypeI) tfschema.Schema {
 			common.NOTIFICATION_CONDITION_FAILURE,
 			common.NOTIFICATION_CONDITION_SUCCESS_OR_FAILURE,
 		),
-		"frequency": schema.ResourceRequiredSingleNestedAttribute("Frequency Schedule for this Task.",
-			map[string]tfschema.Attribute{
-				"schedule": schema.ResourceRequiredStringEnum(
-					"Type of Schedule.",
-					common.FREQUENCY_SCHEDULE_MANUAL,
-					common.FREQUENCY_SCHEDULE_ONCE,
-					common.FREQUENCY_SCHEDULE_HOURLY,
-					common.FREQUENCY_SCHEDULE_DAILY,
-					common.FREQUENCY_SCHEDULE_WEEKLY,
-					common.FREQUENCY_SCHEDULE_MONTHLY,
-					common.FREQUENCY_SCHEDULE_CRON,
-				),
-				"start_date": schema.ResourceOptionalInt64(
-					"Start date of the task represented in unix timestamp. Sonatype Nexus Repository persists this in milliseconds, so values for any modern date are 13 digits (e.g. `1777167000000` for 2026-04-26 01:30 UTC). Does not apply for \"manual\" schedule.",
-				),
-				"timezone_offset": schema.ResourceOptionalString("The offset time zone of the client. Example: -05:00"),
-				"recurring_days": func() tfschema.ListAttribute {
-					thisAttr := schema.ResourceOptionalInt32List(
-						`Array with the number of the days the task must run.
+		"frequency": func() tfschema.SingleNestedAttribute {
+			thisAttr := schema.ResourceRequiredSingleNestedAttribute("Frequency Schedule for this Task.",
+				map[string]tfschema.Attribute{
+					"schedule": schema.ResourceRequiredStringEnum(
+						"Type of Schedule.",
+						common.FREQUENCY_SCHEDULE_MANUAL,
+						common.FREQUENCY_SCHEDULE_ONCE,
+						common.FREQUENCY_SCHEDULE_HOURLY,
+						common.FREQUENCY_SCHEDULE_DAILY,
+						common.FREQUENCY_SCHEDULE_WEEKLY,
+						common.FREQUENCY_SCHEDULE_MONTHLY,
+						common.FREQUENCY_SCHEDULE_CRON,
+					),
+					"start_date": schema.ResourceOptionalInt64(
+						"Start date of the task represented in unix timestamp. Sonatype Nexus Repository persists this in milliseconds, so values for any modern date are 13 digits (e.g. `1777167000000` for 2026-04-26 01:30 UTC). Does not apply for \"manual\" schedule.",
+					),
+					"timezone_offset": func() tfschema.StringAttribute {
+						thisAttr := schema.ResourceComputedOptionalString(
+							"The offset time zone of the client. Example: -05:00. Calculated from `timezone` when that is given.",
+						)
+						thisAttr.Validators = []validator.String{
+							stringvalidator.RegexMatches(
+								regexp.MustCompile(`^[+-]\d{2}:\d{2}$`),
+								"must be an offset from UTC in the form +HH:MM or -HH:MM",
+							),
+							stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("timezone")),
+						}
+						return thisAttr
+					}(),
+					"timezone": schema.ResourceOptionalStringWithValidators(
+						"IANA Time Zone name for the schedule (e.g. `Europe/London`) - an alternative to `timezone_offset` that follows daylight saving time. The provider sends Sonatype Nexus Repository the offset from UTC at the next run of the Task, and plans a change to `timezone_offset` whenever that offset changes.",
+						validators.TimeZoneName(),
+					),
+					"recurring_days": func() tfschema.ListAttribute {
+						thisAttr := schema.ResourceOptionalInt32List(
+							`Array with the number of the days the task must run.
 
 - For "weekly" schedule allowed values, 1 to 7.
 - For "monthly" schedule allowed values, 1 to 31.`,
-					)
-					thisAttr.Validators = []validator.List{
-						listvalidator.SizeAtLeast(1),
-					}
-					return thisAttr
-				}(),
-				"cron_expression": schema.ResourceOptionalString("Cron expression for the task. Only applies for for \"cron\" schedule."),
-			},
+						)
+						thisAttr.Validators = []validator.List{
+							listvalidator.SizeAtLeast(1),
+							listvalidator.ValueInt32sAre(int32validator.Between(1, 31)),
+						}
+						return thisAttr
+					}(),
+					"cron_expression": schema.ResourceOptionalStringWithValidators(
+						"Quartz cron expression for the task (seconds minutes hours day-of-month month day-of-week [year]), e.g. `0 0 1 * * ?`. Only applies for \"cron\" schedule.",
+						validators.QuartzCronExpression(),
+					),
+				},
+			)
+			thisAttr.Validators = []validator.Object{
+				validators.TaskFrequency(),
+			}
+			return thisAttr
+		}(),
+		"next_fire_times": schema.ResourceComputedStringList(
+			fmt.Sprintf("The next %d times (RFC 3339) this Task is scheduled to run, calculated from `frequency` - in the time zone given by `timezone` or `timezone_offset`, or UTC. Recalculated whenever `frequency` changes and when refreshed. Empty for a \"manual\" schedule, or when the times depend on a `start_date` that is not set.", common.TASK_NEXT_FIRE_TIMES_COUNT),
 		),
 		"last_updated": schema.ResourceLastUpdated(),
 	}
@@ -337,6 +547,12 @@ func taskSchema(tt tasktype.TaskTypeI) tfschema.Schema {
 		attributes["properties"] = schema.ResourceRequiredSingleNestedAttribute("Properties specific to this Task type", propertiesSchema)
 	}
 
+	if withAttributes, ok := tt.(tasktype.TaskTypeWithSchemaAttributes); ok {
+		for name, attr := range withAttributes.SchemaAttributes() {
+			attributes[name] = attr
+		}
+	}
+
 	return tfschema.Schema{
 		MarkdownDescription: tt.MarkdownDescription(),
 		Attributes:          attributes,
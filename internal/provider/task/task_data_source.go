@@ -55,11 +55,16 @@ func (d *taskDataSource) Metadata(_ context.Context, req datasource.MetadataRequ
 // Schema defines the schema for the data source.
 func (d *taskDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = tfschema.Schema{
-		Description: "Use this data source to get a single Task by ID",
+		Description: "Use this data source to get a single Task by ID, including its runtime status",
 		Attributes: map[string]tfschema.Attribute{
-			"id":   schema.DataSourceRequiredString("The ID of the Task."),
-			"name": schema.DataSourceComputedString("The name of the Task."),
-			"type": schema.DataSourceComputedString("The type of Task."),
+			"id":              schema.DataSourceRequiredString("The ID of the Task."),
+			"name":            schema.DataSourceComputedString("The name of the Task."),
+			"type":            schema.DataSourceComputedString("The type of Task."),
+			"current_state":   schema.DataSourceComputedString("The current state of the Task - `WAITING`, `RUNNING` or `DONE`."),
+			"last_run":        schema.DataSourceComputedString("When the Task last ran (RFC 3339), if it has run."),
+			"last_run_result": schema.DataSourceComputedString("The result of the last run of the Task (e.g. `OK` or `FAILED`), if it has run."),
+			"next_run":        schema.DataSourceComputedString("When the Task is next scheduled to run (RFC 3339), if it is scheduled."),
+			"message":         schema.DataSourceComputedString("Status message reported for the Task."),
 		},
 	}
 }
@@ -42,3 +42,87 @@ func NewTaskRepositoryMavenRemoveSnapshotsResource() resource.Resource {
 		TaskType: tasktype.NewRepositoryMavenRemoveSnapshotsTask(),
 	}
 }
+
+// NewTaskRepositoryAptRebuildMetadataResource is a helper function to simplify the provider implementation.
+func NewTaskRepositoryAptRebuildMetadataResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewRepositoryAptRebuildMetadataTask(),
+	}
+}
+
+// NewTaskRepositoryHelmRebuildMetadataResource is a helper function to simplify the provider implementation.
+func NewTaskRepositoryHelmRebuildMetadataResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewRepositoryHelmRebuildMetadataTask(),
+	}
+}
+
+// NewTaskRepositoryMavenRebuildMetadataResource is a helper function to simplify the provider implementation.
+func NewTaskRepositoryMavenRebuildMetadataResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewRepositoryMavenRebuildMetadataTask(),
+	}
+}
+
+// NewTaskRepositoryNpmRebuildMetadataResource is a helper function to simplify the provider implementation.
+func NewTaskRepositoryNpmRebuildMetadataResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewRepositoryNpmRebuildMetadataTask(),
+	}
+}
+
+// NewTaskRepositoryPypiRebuildMetadataResource is a helper function to simplify the provider implementation.
+func NewTaskRepositoryPypiRebuildMetadataResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewRepositoryPypiRebuildMetadataTask(),
+	}
+}
+
+// NewTaskRepositoryRebuildIndexResource is a helper function to simplify the provider implementation.
+func NewTaskRepositoryRebuildIndexResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewRepositoryRebuildIndexTask(),
+	}
+}
+
+// NewTaskRepositoryRubyRebuildVersionsResource is a helper function to simplify the provider implementation.
+func NewTaskRepositoryRubyRebuildVersionsResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewRepositoryRubyRebuildVersionsTask(),
+	}
+}
+
+// NewTaskRepositoryYumRebuildMetadataResource is a helper function to simplify the provider implementation.
+func NewTaskRepositoryYumRebuildMetadataResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewRepositoryYumRebuildMetadataTask(),
+	}
+}
+
+// NewTaskRepositoryExportResource is a helper function to simplify the provider implementation.
+func NewTaskRepositoryExportResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewRepositoryExportTask(),
+	}
+}
+
+// NewTaskRepositoryImportResource is a helper function to simplify the provider implementation.
+func NewTaskRepositoryImportResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewRepositoryImportTask(),
+	}
+}
+
+// NewTaskRepositoryMavenPurgeUnusedSnapshotsResource is a helper function to simplify the provider implementation.
+func NewTaskRepositoryMavenPurgeUnusedSnapshotsResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewRepositoryMavenPurgeUnusedSnapshotsTask(),
+	}
+}
+
+// NewTaskRepositoryPurgeUnusedResource is a helper function to simplify the provider implementation.
+func NewTaskRepositoryPurgeUnusedResource() resource.Resource {
+	return &taskResource{
+		TaskType: tasktype.NewRepositoryPurgeUnusedTask(),
+	}
+}
@@ -18,6 +18,7 @@ package task_test
 
 import (
 	"fmt"
+	"regexp"
 	"terraform-provider-sonatyperepo/internal/provider/common"
 	utils_test "terraform-provider-sonatyperepo/internal/provider/utils"
 	"testing"
@@ -176,3 +177,385 @@ resource "%s" "test_task" {
 		},
 	})
 }
+
+func TestAccTaskRepositoryMavenRebuildMetadataResource(t *testing.T) {
+
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceType := "sonatyperepo_task_repository_maven_rebuild_metadata"
+	resourceName := fmt.Sprintf(resourceNameF, resourceType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Artifact ID without Group ID is rejected
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test_task" {
+  name = "test-repository-maven-rebuild-metadata-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    repository_name = "*"
+    artifact_id = "commons-lang3"
+  }
+}
+`, resourceType, randomString),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatyperepo_repository_maven2_hosted" "repo" {
+   name = "maven-hosted-repo-test-%s"
+   online = true
+   storage = {
+     blob_store_name = "default"
+     strict_content_type_validation = true
+ 	  write_policy = "ALLOW_ONCE"
+   }
+   maven = {
+     content_disposition = "ATTACHMENT"
+     layout_policy = "STRICT"
+     version_policy = "RELEASE"
+   }
+}
+resource "%s" "test_task" {
+  name = "test-repository-maven-rebuild-metadata-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    repository_name = sonatyperepo_repository_maven2_hosted.repo.name
+    group_id = "org.apache.commons"
+    artifact_id = "commons-lang3"
+  }
+}
+`, randomString, resourceType, randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("test-repository-maven-rebuild-metadata-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, fieldFrequencySchedule, common.FREQUENCY_SCHEDULE_MANUAL),
+					resource.TestCheckResourceAttr(resourceName, "properties.repository_name", fmt.Sprintf("maven-hosted-repo-test-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "properties.group_id", "org.apache.commons"),
+					resource.TestCheckResourceAttr(resourceName, "properties.artifact_id", "commons-lang3"),
+					resource.TestCheckNoResourceAttr(resourceName, "properties.base_version"),
+					resource.TestCheckResourceAttr(resourceName, "properties.rebuild_checksums", fmt.Sprintf("%t", common.TASK_REPOSITORY_MAVEN_REBUILD_METADATA_DEFAULT_REBUILD_CHECKSUMS)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTaskRepositoryNpmRebuildMetadataResource(t *testing.T) {
+
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceType := "sonatyperepo_task_repository_npm_rebuild_metadata"
+	resourceName := fmt.Sprintf(resourceNameF, resourceType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatyperepo_repository_npm_hosted" "repo" {
+   name = "npm-hosted-repo-test-%s"
+   online = true
+   storage = {
+     blob_store_name = "default"
+     strict_content_type_validation = true
+ 	  write_policy = "ALLOW_ONCE"
+   }
+}
+resource "%s" "test_task" {
+  name = "test-repository-npm-rebuild-metadata-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    repository_name = sonatyperepo_repository_npm_hosted.repo.name
+  }
+}
+`, randomString, resourceType, randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("test-repository-npm-rebuild-metadata-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, fieldFrequencySchedule, common.FREQUENCY_SCHEDULE_MANUAL),
+					resource.TestCheckResourceAttr(resourceName, "properties.repository_name", fmt.Sprintf("npm-hosted-repo-test-%s", randomString)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTaskRepositoryRebuildIndexResource(t *testing.T) {
+
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceType := "sonatyperepo_task_repository_rebuild_index"
+	resourceName := fmt.Sprintf(resourceNameF, resourceType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test_task" {
+  name = "test-repository-rebuild-index-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    repository_name = "*"
+  }
+}
+`, resourceType, randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("test-repository-rebuild-index-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, fieldFrequencySchedule, common.FREQUENCY_SCHEDULE_MANUAL),
+					resource.TestCheckResourceAttr(resourceName, "properties.repository_name", "*"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTaskRepositoryExportResource(t *testing.T) {
+
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceType := "sonatyperepo_task_repository_export"
+	resourceName := fmt.Sprintf(resourceNameF, resourceType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatyperepo_repository_npm_hosted" "repo" {
+   name = "npm-hosted-repo-test-%s"
+   online = true
+   storage = {
+     blob_store_name = "default"
+     strict_content_type_validation = true
+ 	  write_policy = "ALLOW_ONCE"
+   }
+}
+resource "%s" "test_task" {
+  name = "test-repository-export-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    repository_name = sonatyperepo_repository_npm_hosted.repo.name
+    file_path = "/tmp/export-%s"
+  }
+}
+`, randomString, resourceType, randomString, randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("test-repository-export-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, fieldFrequencySchedule, common.FREQUENCY_SCHEDULE_MANUAL),
+					resource.TestCheckResourceAttr(resourceName, "properties.repository_name", fmt.Sprintf("npm-hosted-repo-test-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "properties.file_path", fmt.Sprintf("/tmp/export-%s", randomString)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTaskRepositoryImportResource(t *testing.T) {
+
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceType := "sonatyperepo_task_repository_import"
+	resourceName := fmt.Sprintf(resourceNameF, resourceType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Relative paths are rejected
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test_task" {
+  name = "test-repository-import-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    repository_name = "npm-hosted"
+    file_path = "imports/npm"
+  }
+}
+`, resourceType, randomString),
+				ExpectError: regexp.MustCompile("must be an absolute path"),
+			},
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "sonatyperepo_repository_npm_hosted" "repo" {
+   name = "npm-hosted-repo-test-%s"
+   online = true
+   storage = {
+     blob_store_name = "default"
+     strict_content_type_validation = true
+ 	  write_policy = "ALLOW_ONCE"
+   }
+}
+resource "%s" "test_task" {
+  name = "test-repository-import-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    repository_name = sonatyperepo_repository_npm_hosted.repo.name
+    file_path = "/tmp/import-%s"
+    dry_run = true
+  }
+}
+`, randomString, resourceType, randomString, randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("test-repository-import-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, fieldFrequencySchedule, common.FREQUENCY_SCHEDULE_MANUAL),
+					resource.TestCheckResourceAttr(resourceName, "properties.repository_name", fmt.Sprintf("npm-hosted-repo-test-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "properties.file_path", fmt.Sprintf("/tmp/import-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "properties.dry_run", "true"),
+					resource.TestCheckResourceAttr(resourceName, "properties.hard_links_enabled", fmt.Sprintf("%t", common.TASK_REPOSITORY_IMPORT_DEFAULT_HARD_LINKS_ENABLED)),
+					resource.TestCheckResourceAttr(resourceName, "properties.delete_source_files", fmt.Sprintf("%t", common.TASK_REPOSITORY_IMPORT_DEFAULT_DELETE_SOURCE_FILES)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTaskRepositoryPurgeUnusedResource(t *testing.T) {
+
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceType := "sonatyperepo_task_repository_purge_unused"
+	resourceName := fmt.Sprintf(resourceNameF, resourceType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Last used must be at least one day
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test_task" {
+  name = "test-repository-purge-unused-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    repository_name = "*"
+    last_used = 0
+  }
+}
+`, resourceType, randomString),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value"),
+			},
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test_task" {
+  name = "test-repository-purge-unused-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    repository_name = "*"
+    last_used = 30
+  }
+}
+`, resourceType, randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("test-repository-purge-unused-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, fieldFrequencySchedule, common.FREQUENCY_SCHEDULE_MANUAL),
+					resource.TestCheckResourceAttr(resourceName, "properties.repository_name", "*"),
+					resource.TestCheckResourceAttr(resourceName, "properties.last_used", "30"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTaskRepositoryMavenPurgeUnusedSnapshotsResource(t *testing.T) {
+
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceType := "sonatyperepo_task_repository_maven_purge_unused_snapshots"
+	resourceName := fmt.Sprintf(resourceNameF, resourceType)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test_task" {
+  name = "test-repository-maven-purge-unused-snapshots-%s"
+  enabled = true
+  alert_email = ""
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    repository_name = "*"
+    last_used = 14
+  }
+}
+`, resourceType, randomString),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("test-repository-maven-purge-unused-snapshots-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, fieldFrequencySchedule, common.FREQUENCY_SCHEDULE_MANUAL),
+					resource.TestCheckResourceAttr(resourceName, "properties.repository_name", "*"),
+					resource.TestCheckResourceAttr(resourceName, "properties.last_used", "14"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,217 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package task_test
+
+import (
+	"fmt"
+	"regexp"
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	utils_test "terraform-provider-sonatyperepo/internal/provider/utils"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const resourceTypeTask = "sonatyperepo_task"
+
+func TestAccTaskResource(t *testing.T) {
+
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceNameTask := fmt.Sprintf(resourceNameF, resourceTypeTask)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test_task" {
+  name = "test-task-%s"
+  type = "%s"
+  enabled = true
+  notification_condition = "FAILURE"
+  frequency = {
+    schedule = "manual"
+  }
+  properties = {
+    blobstoreName = "default"
+  }
+}
+`, resourceTypeTask, randomString, common.TASK_TYPE_BLOBSTORE_DELETE_TEMP_FILES),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameTask, "id"),
+					resource.TestCheckResourceAttr(resourceNameTask, "name", fmt.Sprintf("test-task-%s", randomString)),
+					resource.TestCheckResourceAttr(resourceNameTask, "type", common.TASK_TYPE_BLOBSTORE_DELETE_TEMP_FILES.String()),
+					resource.TestCheckResourceAttr(resourceNameTask, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceNameTask, fieldFrequencySchedule, common.FREQUENCY_SCHEDULE_MANUAL),
+					resource.TestCheckResourceAttr(resourceNameTask, "properties.blobstoreName", "default"),
+				),
+			},
+			// Import testing - only `properties` already in state are refreshed, and
+			// Sonatype Nexus Repository fills in defaults that are not configured here,
+			// so those attributes plus `last_updated` cannot be verified against the
+			// imported state.
+			{
+				ResourceName:      resourceNameTask,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"alert_email",
+					"enabled",
+					"frequency",
+					"last_updated",
+					"notification_condition",
+					"properties",
+				},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTaskResourceCronSchedule(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+	resourceNameTask := fmt.Sprintf(resourceNameF, resourceTypeTask)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: taskResourceConfigWithFrequency(randomString, `
+    schedule        = "cron"
+    cron_expression = "0 0 1 * * ?"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceNameTask, "id"),
+					resource.TestCheckResourceAttr(resourceNameTask, fieldFrequencySchedule, common.FREQUENCY_SCHEDULE_CRON),
+					resource.TestCheckResourceAttr(resourceNameTask, "next_fire_times.#", fmt.Sprintf("%d", common.TASK_NEXT_FIRE_TIMES_COUNT)),
+					resource.TestMatchResourceAttr(resourceNameTask, "next_fire_times.0", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T01:00:00Z$`)),
+				),
+			},
+			// Update to follow a named Time Zone
+			{
+				Config: taskResourceConfigWithFrequency(randomString, `
+    schedule        = "cron"
+    cron_expression = "0 0 1 * * ?"
+    timezone        = "Europe/London"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceNameTask, "frequency.timezone", "Europe/London"),
+					resource.TestMatchResourceAttr(resourceNameTask, "frequency.timezone_offset", regexp.MustCompile(`^\+0[01]:00$`)),
+					resource.TestMatchResourceAttr(resourceNameTask, "next_fire_times.0", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T01:00:00\+0[01]:00$`)),
+				),
+			},
+			// Stop following the named Time Zone - the calculated offset is cleared with it
+			{
+				Config: taskResourceConfigWithFrequency(randomString, `
+    schedule        = "cron"
+    cron_expression = "0 0 1 * * ?"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr(resourceNameTask, "frequency.timezone"),
+					resource.TestCheckNoResourceAttr(resourceNameTask, "frequency.timezone_offset"),
+					resource.TestMatchResourceAttr(resourceNameTask, "next_fire_times.0", regexp.MustCompile(`^\d{4}-\d{2}-\d{2}T01:00:00Z$`)),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func TestAccTaskResourceFrequencyValidation(t *testing.T) {
+	randomString := acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: utils_test.TestAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: taskResourceConfigWithFrequency(randomString, `
+    schedule        = "cron"
+    cron_expression = "0 0 1 * *"`),
+				ExpectError: regexp.MustCompile("Invalid cron expression"),
+			},
+			{
+				Config: taskResourceConfigWithFrequency(randomString, `
+    schedule = "cron"`),
+				ExpectError: regexp.MustCompile("cron_expression is required"),
+			},
+			{
+				Config: taskResourceConfigWithFrequency(randomString, `
+    schedule        = "daily"
+    cron_expression = "0 0 1 * * ?"`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: taskResourceConfigWithFrequency(randomString, `
+    schedule = "weekly"`),
+				ExpectError: regexp.MustCompile("recurring_days is required"),
+			},
+			{
+				Config: taskResourceConfigWithFrequency(randomString, `
+    schedule       = "weekly"
+    recurring_days = [1, 8]`),
+				ExpectError: regexp.MustCompile("must be between 1 and 7"),
+			},
+			{
+				Config: taskResourceConfigWithFrequency(randomString, `
+    schedule       = "daily"
+    recurring_days = [1]`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			{
+				Config: taskResourceConfigWithFrequency(randomString, `
+    schedule   = "daily"
+    start_date = 1777167000`),
+				ExpectError: regexp.MustCompile("expects milliseconds"),
+			},
+			{
+				Config: taskResourceConfigWithFrequency(randomString, `
+    schedule        = "daily"
+    start_date      = 1777167000000
+    timezone_offset = "Europe/London"`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			{
+				Config: taskResourceConfigWithFrequency(randomString, `
+    schedule = "daily"
+    timezone = "Mars/Olympus_Mons"`),
+				ExpectError: regexp.MustCompile("Invalid Time Zone"),
+			},
+			{
+				Config: taskResourceConfigWithFrequency(randomString, `
+    schedule        = "daily"
+    timezone        = "Europe/London"
+    timezone_offset = "+00:00"`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	})
+}
+
+func taskResourceConfigWithFrequency(randomString, frequency string) string {
+	return fmt.Sprintf(utils_test.ProviderConfig+`
+resource "%s" "test_task" {
+  name = "test-task-%s"
+  type = "%s"
+  enabled = true
+  notification_condition = "FAILURE"
+  frequency = {%s
+  }
+  properties = {
+    blobstoreName = "default"
+  }
+}
+`, resourceTypeTask, randomString, common.TASK_TYPE_BLOBSTORE_DELETE_TEMP_FILES, frequency)
+}
@@ -19,11 +19,15 @@ package tasktype
 import (
 	"context"
 	"net/http"
+	"regexp"
 	"terraform-provider-sonatyperepo/internal/provider/common"
 	"terraform-provider-sonatyperepo/internal/provider/model"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -110,3 +114,274 @@ func (f *BlobstoreCompactTask) UpdateStateFromPlanForUpdate(plan any, state any)
 
 	return planModel
 }
+
+const (
+	taskDatePattern = `^\d{4}-\d{2}-\d{2}$`
+)
+
+// --------------------------------------------
+// Blob Store Tasks
+//
+// These Tasks all take only the Blob Store to run against.
+// --------------------------------------------
+type BlobstoreTask struct {
+	BaseTaskType
+	blobStoreDescription string
+}
+
+func NewBlobstoreDeleteTempFilesTask() *BlobstoreTask {
+	return &BlobstoreTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Admin - Delete blob store temporary files",
+			taskType:   common.TASK_TYPE_BLOBSTORE_DELETE_TEMP_FILES,
+		},
+		blobStoreDescription: "The Blob Store to delete temporary files from.",
+	}
+}
+
+func NewBlobstoreMetricsReconcileTask() *BlobstoreTask {
+	return &BlobstoreTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Admin - Recalculate blob store storage",
+			taskType:   common.TASK_TYPE_BLOBSTORE_METRICS_RECONCILE,
+		},
+		blobStoreDescription: "The Blob Store to recalculate storage metrics for.",
+	}
+}
+
+// --------------------------------------------
+// Blob Store Task Functions
+// --------------------------------------------
+func (f *BlobstoreTask) DoCreateRequest(plan any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*common.TaskApiModel, *http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskBlobstoreModel)
+
+	// Call API to Create
+	return taskService.CreateTask(ctx, planModel.ToApiCreateModel(f.Type(), version))
+}
+
+func (f *BlobstoreTask) DoUpdateRequest(plan any, state any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskBlobstoreModel)
+
+	// Cast to correct State Model Type
+	stateModel := (state).(model.TaskBlobstoreModel)
+
+	// Call API to Update
+	return taskService.UpdateTask(ctx, stateModel.Id.ValueString(), planModel.ToApiUpdateModel(version))
+}
+
+func (f *BlobstoreTask) PlanAsModel(ctx context.Context, plan tfsdk.Plan) (any, diag.Diagnostics) {
+	var planModel model.TaskBlobstoreModel
+	return planModel, plan.Get(ctx, &planModel)
+}
+
+func (f *BlobstoreTask) PropertiesSchema() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{
+		"blob_store_name": schema.ResourceRequiredStringWithLengthAtLeast(f.blobStoreDescription, 1),
+	}
+}
+
+func (f *BlobstoreTask) StateAsModel(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics) {
+	var stateModel model.TaskBlobstoreModel
+	return stateModel, state.Get(ctx, &stateModel)
+}
+
+func (f *BlobstoreTask) UpdatePlanForState(plan any) any {
+	var planModel = (plan).(model.TaskBlobstoreModel)
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return planModel
+}
+
+func (f *BlobstoreTask) UpdateStateFromApi(state any, api any) any {
+	stateModel := (state).(model.TaskBlobstoreModel)
+	apiModel := (api).(common.TaskApiModel)
+	stateModel.MapFromApi(&apiModel)
+	return stateModel
+}
+
+func (f *BlobstoreTask) UpdateStateFromPlanForUpdate(plan any, state any) any {
+	planModel := (plan).(model.TaskBlobstoreModel)
+	stateModel := (state).(model.TaskBlobstoreModel)
+
+	planModel.Id = stateModel.Id
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return planModel
+}
+
+// --------------------------------------------
+// Blob Store Plan Reconciliation
+// --------------------------------------------
+type BlobstorePlanReconciliationTask struct {
+	BaseTaskType
+}
+
+func NewBlobstorePlanReconciliationTask() *BlobstorePlanReconciliationTask {
+	return &BlobstorePlanReconciliationTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Repair - Plan blob store reconciliation",
+			taskType:   common.TASK_TYPE_BLOBSTORE_PLANRECONCILIATION,
+		},
+	}
+}
+
+// --------------------------------------------
+// Blob Store Plan Reconciliation Functions
+// --------------------------------------------
+func (f *BlobstorePlanReconciliationTask) DoCreateRequest(plan any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*common.TaskApiModel, *http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskBlobstorePlanReconciliationModel)
+
+	// Call API to Create
+	return taskService.CreateTask(ctx, planModel.ToApiCreateModel(version))
+}
+
+func (f *BlobstorePlanReconciliationTask) DoUpdateRequest(plan any, state any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskBlobstorePlanReconciliationModel)
+
+	// Cast to correct State Model Type
+	stateModel := (state).(model.TaskBlobstorePlanReconciliationModel)
+
+	// Call API to Update
+	return taskService.UpdateTask(ctx, stateModel.Id.ValueString(), planModel.ToApiUpdateModel(version))
+}
+
+func (f *BlobstorePlanReconciliationTask) PlanAsModel(ctx context.Context, plan tfsdk.Plan) (any, diag.Diagnostics) {
+	var planModel model.TaskBlobstorePlanReconciliationModel
+	return planModel, plan.Get(ctx, &planModel)
+}
+
+func (f *BlobstorePlanReconciliationTask) PropertiesSchema() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{
+		"blob_store_name": schema.ResourceRequiredStringWithLengthAtLeast("The Blob Store to plan reconciliation for.", 1),
+		"since_days": schema.ResourceOptionalInt32WithValidator(
+			"Only reconcile blobs created in this many days before the Task runs. Cannot be used with `start_date` and `end_date`. When no period is given, the whole Blob Store is reconciled.",
+			int32validator.AtLeast(1),
+			int32validator.ConflictsWith(
+				path.MatchRelative().AtParent().AtName("start_date"),
+				path.MatchRelative().AtParent().AtName("end_date"),
+			),
+		),
+		"start_date": schema.ResourceOptionalStringWithValidators(
+			"Only reconcile blobs created on or after this date (`YYYY-MM-DD`). Requires `end_date`.",
+			stringvalidator.RegexMatches(regexp.MustCompile(taskDatePattern), "must be a date in the format YYYY-MM-DD"),
+			stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("end_date")),
+		),
+		"end_date": schema.ResourceOptionalStringWithValidators(
+			"Only reconcile blobs created on or before this date (`YYYY-MM-DD`). Requires `start_date`.",
+			stringvalidator.RegexMatches(regexp.MustCompile(taskDatePattern), "must be a date in the format YYYY-MM-DD"),
+			stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("start_date")),
+		),
+	}
+}
+
+func (f *BlobstorePlanReconciliationTask) StateAsModel(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics) {
+	var stateModel model.TaskBlobstorePlanReconciliationModel
+	return stateModel, state.Get(ctx, &stateModel)
+}
+
+func (f *BlobstorePlanReconciliationTask) UpdatePlanForState(plan any) any {
+	var planModel = (plan).(model.TaskBlobstorePlanReconciliationModel)
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return planModel
+}
+
+func (f *BlobstorePlanReconciliationTask) UpdateStateFromApi(state any, api any) any {
+	stateModel := (state).(model.TaskBlobstorePlanReconciliationModel)
+	apiModel := (api).(common.TaskApiModel)
+	stateModel.MapFromApi(&apiModel)
+	return stateModel
+}
+
+func (f *BlobstorePlanReconciliationTask) UpdateStateFromPlanForUpdate(plan any, state any) any {
+	planModel := (plan).(model.TaskBlobstorePlanReconciliationModel)
+	stateModel := (state).(model.TaskBlobstorePlanReconciliationModel)
+
+	planModel.Id = stateModel.Id
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return planModel
+}
+
+// --------------------------------------------
+// Blob Store Execute Reconciliation Plan
+// --------------------------------------------
+type BlobstoreExecuteReconciliationPlanTask struct {
+	BaseTaskType
+}
+
+func NewBlobstoreExecuteReconciliationPlanTask() *BlobstoreExecuteReconciliationPlanTask {
+	return &BlobstoreExecuteReconciliationPlanTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Repair - Execute blob store reconciliation plan",
+			taskType:   common.TASK_TYPE_BLOBSTORE_EXECUTERECONCILIATIONPLAN,
+		},
+	}
+}
+
+// --------------------------------------------
+// Blob Store Execute Reconciliation Plan Functions
+// --------------------------------------------
+func (f *BlobstoreExecuteReconciliationPlanTask) DoCreateRequest(plan any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*common.TaskApiModel, *http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskBlobstoreExecuteReconciliationPlanModel)
+
+	// Call API to Create
+	return taskService.CreateTask(ctx, planModel.ToApiCreateModel(version))
+}
+
+func (f *BlobstoreExecuteReconciliationPlanTask) DoUpdateRequest(plan any, state any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskBlobstoreExecuteReconciliationPlanModel)
+
+	// Cast to correct State Model Type
+	stateModel := (state).(model.TaskBlobstoreExecuteReconciliationPlanModel)
+
+	// Call API to Update
+	return taskService.UpdateTask(ctx, stateModel.Id.ValueString(), planModel.ToApiUpdateModel(version))
+}
+
+func (f *BlobstoreExecuteReconciliationPlanTask) PlanAsModel(ctx context.Context, plan tfsdk.Plan) (any, diag.Diagnostics) {
+	var planModel model.TaskBlobstoreExecuteReconciliationPlanModel
+	return planModel, plan.Get(ctx, &planModel)
+}
+
+func (f *BlobstoreExecuteReconciliationPlanTask) PropertiesSchema() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{
+		"blob_store_name": schema.ResourceRequiredStringWithLengthAtLeast("The Blob Store to execute reconciliation plans for.", 1),
+		"plan_id": schema.ResourceOptionalStringWithLengthAtLeast(
+			"The ID of a single reconciliation plan to execute. Plan IDs are only known once a `sonatyperepo_task_blobstore_planreconciliation` Task has run, so leave this unset to execute every pending plan for the Blob Store.",
+			1,
+		),
+	}
+}
+
+func (f *BlobstoreExecuteReconciliationPlanTask) StateAsModel(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics) {
+	var stateModel model.TaskBlobstoreExecuteReconciliationPlanModel
+	return stateModel, state.Get(ctx, &stateModel)
+}
+
+func (f *BlobstoreExecuteReconciliationPlanTask) UpdatePlanForState(plan any) any {
+	var planModel = (plan).(model.TaskBlobstoreExecuteReconciliationPlanModel)
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return planModel
+}
+
+func (f *BlobstoreExecuteReconciliationPlanTask) UpdateStateFromApi(state any, api any) any {
+	stateModel := (state).(model.TaskBlobstoreExecuteReconciliationPlanModel)
+	apiModel := (api).(common.TaskApiModel)
+	stateModel.MapFromApi(&apiModel)
+	return stateModel
+}
+
+func (f *BlobstoreExecuteReconciliationPlanTask) UpdateStateFromPlanForUpdate(plan any, state any) any {
+	planModel := (plan).(model.TaskBlobstoreExecuteReconciliationPlanModel)
+	stateModel := (state).(model.TaskBlobstoreExecuteReconciliationPlanModel)
+
+	planModel.Id = stateModel.Id
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return planModel
+}
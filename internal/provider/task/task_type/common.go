@@ -77,3 +77,10 @@ type TaskTypeI interface {
 	UpdateStateFromApi(state any, api any) any
 	UpdateStateFromPlanForUpdate(plan any, state any) any
 }
+
+// TaskTypeWithSchemaAttributes is implemented by Task types that need top-level attributes
+// beyond those common to every Task and their `properties`
+// --------------------------------------------
+type TaskTypeWithSchemaAttributes interface {
+	SchemaAttributes() map[string]tfschema.Attribute
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasktype
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// TASK_TYPE_ANY stands in for the Task type of a GenericTask, which is only known from configuration
+const TASK_TYPE_ANY common.TaskType = "any"
+
+type GenericTask struct {
+	BaseTaskType
+}
+
+func NewGenericTask() *GenericTask {
+	return &GenericTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Any Task type",
+			taskType:   TASK_TYPE_ANY,
+		},
+	}
+}
+
+// --------------------------------------------
+// Generic Task Functions
+// --------------------------------------------
+func (f *GenericTask) DoCreateRequest(plan any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*common.TaskApiModel, *http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskGenericModel)
+
+	// Call API to Create
+	return taskService.CreateTask(ctx, planModel.ToApiCreateModel())
+}
+
+func (f *GenericTask) DoUpdateRequest(plan any, state any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskGenericModel)
+
+	// Cast to correct State Model Type
+	stateModel := (state).(model.TaskGenericModel)
+
+	// Call API to Update
+	return taskService.UpdateTask(ctx, stateModel.Id.ValueString(), planModel.ToApiUpdateModel())
+}
+
+func (f *GenericTask) MarkdownDescription() string {
+	return `Manage a Task of any type supported by Sonatype Nexus Repository.
+
+Use this where there is no dedicated ` + "`sonatyperepo_task_*`" + ` resource for the Task type. Properties are passed to the Tasks API as given, so must use the names the Tasks API expects (e.g. ` + "`repositoryName`" + `).`
+}
+
+func (f *GenericTask) PlanAsModel(ctx context.Context, plan tfsdk.Plan) (any, diag.Diagnostics) {
+	var planModel model.TaskGenericModel
+	return planModel, plan.Get(ctx, &planModel)
+}
+
+// PropertiesSchema is empty - properties are a free-form map, see SchemaAttributes
+func (f *GenericTask) PropertiesSchema() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{}
+}
+
+func (f *GenericTask) ResourceName() string {
+	return "task"
+}
+
+func (f *GenericTask) SchemaAttributes() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{
+		"type": schema.ResourceRequiredStringWithPlanModifier(
+			fmt.Sprintf("The type of Task (e.g. `%s`) - see the `sonatyperepo_tasks` data source for Tasks that already exist.", common.TASK_TYPE_REPOSITORY_NPM_REBUILD_METADATA.String()),
+			[]planmodifier.String{stringplanmodifier.RequiresReplace()},
+		),
+		"properties": schema.ResourceOptionalStringMap("Properties specific to this Task type, keyed by the names the Tasks API expects."),
+	}
+}
+
+func (f *GenericTask) StateAsModel(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics) {
+	var stateModel model.TaskGenericModel
+	return stateModel, state.Get(ctx, &stateModel)
+}
+
+func (f *GenericTask) UpdatePlanForState(plan any) any {
+	var planModel = (plan).(model.TaskGenericModel)
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return planModel
+}
+
+func (f *GenericTask) UpdateStateFromApi(state any, api any) any {
+	stateModel := (state).(model.TaskGenericModel)
+	apiModel := (api).(common.TaskApiModel)
+	stateModel.MapFromApi(&apiModel)
+	return stateModel
+}
+
+func (f *GenericTask) UpdateStateFromPlanForUpdate(plan any, state any) any {
+	planModel := (plan).(model.TaskGenericModel)
+	stateModel := (state).(model.TaskGenericModel)
+
+	planModel.Id = stateModel.Id
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return planModel
+}
@@ -0,0 +1,119 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasktype
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+type H2BackupTask struct {
+	BaseTaskType
+}
+
+func NewH2BackupTask() *H2BackupTask {
+	return &H2BackupTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Admin - Backup H2 Database",
+			taskType:   common.TASK_TYPE_H2_BACKUP_TASK,
+		},
+	}
+}
+
+// --------------------------------------------
+// H2 Backup Functions
+// --------------------------------------------
+func (f *H2BackupTask) DoCreateRequest(plan any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*common.TaskApiModel, *http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskH2BackupModel)
+
+	// Call API to Create
+	return taskService.CreateTask(ctx, planModel.ToApiCreateModel(version))
+}
+
+func (f *H2BackupTask) DoUpdateRequest(plan any, state any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskH2BackupModel)
+
+	// Cast to correct State Model Type
+	stateModel := (state).(model.TaskH2BackupModel)
+
+	// Call API to Update
+	return taskService.UpdateTask(ctx, stateModel.Id.ValueString(), planModel.ToApiUpdateModel(version))
+}
+
+func (f *H2BackupTask) MarkdownDescription() string {
+	return fmt.Sprintf(
+		`Manage Task '%s' (%s)
+
+This task is only available when Sonatype Nexus Repository uses the embedded H2 database.`,
+		f.PublicName(), f.Type().String(),
+	)
+}
+
+func (f *H2BackupTask) PlanAsModel(ctx context.Context, plan tfsdk.Plan) (any, diag.Diagnostics) {
+	var planModel model.TaskH2BackupModel
+	return planModel, plan.Get(ctx, &planModel)
+}
+
+func (f *H2BackupTask) PropertiesSchema() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{
+		"location": schema.ResourceRequiredStringWithLengthAtLeast(
+			"Directory on the Sonatype Nexus Repository server to write backups to. Relative paths are resolved against the data directory.",
+			1,
+		),
+	}
+}
+
+func (f *H2BackupTask) StateAsModel(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics) {
+	var stateModel model.TaskH2BackupModel
+	return stateModel, state.Get(ctx, &stateModel)
+}
+
+func (f *H2BackupTask) UpdatePlanForState(plan any) any {
+	var planModel = (plan).(model.TaskH2BackupModel)
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return planModel
+}
+
+func (f *H2BackupTask) UpdateStateFromApi(state any, api any) any {
+	stateModel := (state).(model.TaskH2BackupModel)
+	apiModel := (api).(common.TaskApiModel)
+	stateModel.MapFromApi(&apiModel)
+	return stateModel
+}
+
+func (f *H2BackupTask) UpdateStateFromPlanForUpdate(plan any, state any) any {
+	planModel := (plan).(model.TaskH2BackupModel)
+	stateModel := (state).(model.TaskH2BackupModel)
+
+	planModel.Id = stateModel.Id
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return planModel
+}
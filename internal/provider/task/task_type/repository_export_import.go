@@ -0,0 +1,225 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasktype
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+const (
+	// Export and Import run on the Sonatype Nexus Repository server, so paths are absolute paths on that
+	// server - either POSIX (/var/exports) or Windows (C:\exports)
+	taskLocalPathPattern = `^(/|[A-Za-z]:[\\/])`
+)
+
+// repositoryExportImportPropertiesSchema returns the properties common to both Export and Import
+func repositoryExportImportPropertiesSchema(repositoryDescription, filePathDescription string) map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{
+		"repository_name": schema.ResourceRequiredStringWithValidators(
+			repositoryDescription,
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.NoneOf("*"),
+		),
+		"file_path": schema.ResourceRequiredStringWithRegex(
+			filePathDescription,
+			regexp.MustCompile(taskLocalPathPattern),
+			"must be an absolute path on the Sonatype Nexus Repository server",
+		),
+	}
+}
+
+// --------------------------------------------
+// Repository Export
+// --------------------------------------------
+type RepositoryExportTask struct {
+	BaseTaskType
+}
+
+func NewRepositoryExportTask() *RepositoryExportTask {
+	return &RepositoryExportTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Repository - Export",
+			taskType:   common.TASK_TYPE_REPOSITORY_EXPORT,
+		},
+	}
+}
+
+// --------------------------------------------
+// Repository Export Functions
+// --------------------------------------------
+func (f *RepositoryExportTask) DoCreateRequest(plan any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*common.TaskApiModel, *http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskRepositoryExportModel)
+
+	// Call API to Create
+	return taskService.CreateTask(ctx, planModel.ToApiCreateModel(version))
+}
+
+func (f *RepositoryExportTask) DoUpdateRequest(plan any, state any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskRepositoryExportModel)
+
+	// Cast to correct State Model Type
+	stateModel := (state).(model.TaskRepositoryExportModel)
+
+	// Call API to Update
+	return taskService.UpdateTask(ctx, stateModel.Id.ValueString(), planModel.ToApiUpdateModel(version))
+}
+
+func (f *RepositoryExportTask) PlanAsModel(ctx context.Context, plan tfsdk.Plan) (any, diag.Diagnostics) {
+	var planModel model.TaskRepositoryExportModel
+	return planModel, plan.Get(ctx, &planModel)
+}
+
+func (f *RepositoryExportTask) PropertiesSchema() map[string]tfschema.Attribute {
+	return repositoryExportImportPropertiesSchema(
+		"The Repository to export.",
+		"Absolute path on the Sonatype Nexus Repository server to export the Repository to.",
+	)
+}
+
+func (f *RepositoryExportTask) StateAsModel(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics) {
+	var stateModel model.TaskRepositoryExportModel
+	return stateModel, state.Get(ctx, &stateModel)
+}
+
+func (f *RepositoryExportTask) UpdatePlanForState(plan any) any {
+	var planModel = (plan).(model.TaskRepositoryExportModel)
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return planModel
+}
+
+func (f *RepositoryExportTask) UpdateStateFromApi(state any, api any) any {
+	stateModel := (state).(model.TaskRepositoryExportModel)
+	apiModel := (api).(common.TaskApiModel)
+	stateModel.MapFromApi(&apiModel)
+	return stateModel
+}
+
+func (f *RepositoryExportTask) UpdateStateFromPlanForUpdate(plan any, state any) any {
+	planModel := (plan).(model.TaskRepositoryExportModel)
+	stateModel := (state).(model.TaskRepositoryExportModel)
+
+	planModel.Id = stateModel.Id
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return planModel
+}
+
+// --------------------------------------------
+// Repository Import
+// --------------------------------------------
+type RepositoryImportTask struct {
+	BaseTaskType
+}
+
+func NewRepositoryImportTask() *RepositoryImportTask {
+	return &RepositoryImportTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Repository - Import",
+			taskType:   common.TASK_TYPE_REPOSITORY_IMPORT,
+		},
+	}
+}
+
+// --------------------------------------------
+// Repository Import Functions
+// --------------------------------------------
+func (f *RepositoryImportTask) DoCreateRequest(plan any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*common.TaskApiModel, *http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskRepositoryImportModel)
+
+	// Call API to Create
+	return taskService.CreateTask(ctx, planModel.ToApiCreateModel(version))
+}
+
+func (f *RepositoryImportTask) DoUpdateRequest(plan any, state any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskRepositoryImportModel)
+
+	// Cast to correct State Model Type
+	stateModel := (state).(model.TaskRepositoryImportModel)
+
+	// Call API to Update
+	return taskService.UpdateTask(ctx, stateModel.Id.ValueString(), planModel.ToApiUpdateModel(version))
+}
+
+func (f *RepositoryImportTask) PlanAsModel(ctx context.Context, plan tfsdk.Plan) (any, diag.Diagnostics) {
+	var planModel model.TaskRepositoryImportModel
+	return planModel, plan.Get(ctx, &planModel)
+}
+
+func (f *RepositoryImportTask) PropertiesSchema() map[string]tfschema.Attribute {
+	attributes := repositoryExportImportPropertiesSchema(
+		"The hosted Repository to import into.",
+		"Absolute path on the Sonatype Nexus Repository server to import files from.",
+	)
+	attributes["hard_links_enabled"] = schema.ResourceOptionalBoolWithDefault(
+		`Use hard links rather than copying files. Only possible when the files are on the same file system as the Blob Store.`,
+		common.TASK_REPOSITORY_IMPORT_DEFAULT_HARD_LINKS_ENABLED,
+	)
+	attributes["delete_source_files"] = schema.ResourceOptionalBoolWithDefault(
+		`Delete the source files once they have been imported. Files are never deleted during a dry run.`,
+		common.TASK_REPOSITORY_IMPORT_DEFAULT_DELETE_SOURCE_FILES,
+	)
+	attributes["dry_run"] = schema.ResourceOptionalBoolWithDefault(
+		`Log what would be imported without importing anything.`,
+		common.TASK_REPOSITORY_IMPORT_DEFAULT_DRY_RUN,
+	)
+	return attributes
+}
+
+func (f *RepositoryImportTask) StateAsModel(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics) {
+	var stateModel model.TaskRepositoryImportModel
+	return stateModel, state.Get(ctx, &stateModel)
+}
+
+func (f *RepositoryImportTask) UpdatePlanForState(plan any) any {
+	var planModel = (plan).(model.TaskRepositoryImportModel)
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return planModel
+}
+
+func (f *RepositoryImportTask) UpdateStateFromApi(state any, api any) any {
+	stateModel := (state).(model.TaskRepositoryImportModel)
+	apiModel := (api).(common.TaskApiModel)
+	stateModel.MapFromApi(&apiModel)
+	return stateModel
+}
+
+func (f *RepositoryImportTask) UpdateStateFromPlanForUpdate(plan any, state any) any {
+	planModel := (plan).(model.TaskRepositoryImportModel)
+	stateModel := (state).(model.TaskRepositoryImportModel)
+
+	planModel.Id = stateModel.Id
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return planModel
+}
@@ -0,0 +1,131 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasktype
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// --------------------------------------------
+// Repository Purge Unused Tasks
+//
+// These Tasks delete components that have not been used for a number of days.
+// --------------------------------------------
+type RepositoryPurgeUnusedTask struct {
+	BaseTaskType
+	repositoryDescription string
+	lastUsedDescription   string
+}
+
+func NewRepositoryPurgeUnusedTask() *RepositoryPurgeUnusedTask {
+	return &RepositoryPurgeUnusedTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Repository - Delete unused components",
+			taskType:   common.TASK_TYPE_REPOSITORY_PURGE_UNUSED,
+		},
+		repositoryDescription: "The proxy Repository to delete unused components from. Use `*` for all proxy Repositories.",
+		lastUsedDescription:   "Delete components that have not been downloaded in this many days.",
+	}
+}
+
+func NewRepositoryMavenPurgeUnusedSnapshotsTask() *RepositoryPurgeUnusedTask {
+	return &RepositoryPurgeUnusedTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Maven - Delete unused SNAPSHOT",
+			taskType:   common.TASK_TYPE_REPOSITORY_MAVEN_PURGE_UNUSED_SNAPSHOTS,
+		},
+		repositoryDescription: "The Maven hosted repository or repository group to delete unused snapshots from. Use `*` for all Maven repositories.",
+		lastUsedDescription:   "Delete snapshots that have not been downloaded in this many days.",
+	}
+}
+
+// --------------------------------------------
+// Repository Purge Unused Functions
+// --------------------------------------------
+func (f *RepositoryPurgeUnusedTask) DoCreateRequest(plan any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*common.TaskApiModel, *http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskRepositoryPurgeUnusedModel)
+
+	// Call API to Create
+	return taskService.CreateTask(ctx, planModel.ToApiCreateModel(f.Type(), version))
+}
+
+func (f *RepositoryPurgeUnusedTask) DoUpdateRequest(plan any, state any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskRepositoryPurgeUnusedModel)
+
+	// Cast to correct State Model Type
+	stateModel := (state).(model.TaskRepositoryPurgeUnusedModel)
+
+	// Call API to Update
+	return taskService.UpdateTask(ctx, stateModel.Id.ValueString(), planModel.ToApiUpdateModel(version))
+}
+
+func (f *RepositoryPurgeUnusedTask) PlanAsModel(ctx context.Context, plan tfsdk.Plan) (any, diag.Diagnostics) {
+	var planModel model.TaskRepositoryPurgeUnusedModel
+	return planModel, plan.Get(ctx, &planModel)
+}
+
+func (f *RepositoryPurgeUnusedTask) PropertiesSchema() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{
+		"repository_name": schema.ResourceRequiredStringWithLengthAtLeast(f.repositoryDescription, 1),
+		"last_used": schema.ResourceRequiredInt32WithValidator(
+			f.lastUsedDescription,
+			int32validator.AtLeast(1),
+		),
+	}
+}
+
+func (f *RepositoryPurgeUnusedTask) StateAsModel(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics) {
+	var stateModel model.TaskRepositoryPurgeUnusedModel
+	return stateModel, state.Get(ctx, &stateModel)
+}
+
+func (f *RepositoryPurgeUnusedTask) UpdatePlanForState(plan any) any {
+	var planModel = (plan).(model.TaskRepositoryPurgeUnusedModel)
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return planModel
+}
+
+func (f *RepositoryPurgeUnusedTask) UpdateStateFromApi(state any, api any) any {
+	stateModel := (state).(model.TaskRepositoryPurgeUnusedModel)
+	apiModel := (api).(common.TaskApiModel)
+	stateModel.MapFromApi(&apiModel)
+	return stateModel
+}
+
+func (f *RepositoryPurgeUnusedTask) UpdateStateFromPlanForUpdate(plan any, state any) any {
+	planModel := (plan).(model.TaskRepositoryPurgeUnusedModel)
+	stateModel := (state).(model.TaskRepositoryPurgeUnusedModel)
+
+	planModel.Id = stateModel.Id
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return planModel
+}
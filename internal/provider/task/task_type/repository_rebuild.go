@@ -0,0 +1,273 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasktype
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+// --------------------------------------------
+// Repository Rebuild Tasks
+//
+// These Tasks all take only the Repository to rebuild metadata (or the search index) for.
+// --------------------------------------------
+type RepositoryRebuildTask struct {
+	BaseTaskType
+	repositoryDescription string
+}
+
+func NewRepositoryAptRebuildMetadataTask() *RepositoryRebuildTask {
+	return &RepositoryRebuildTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Repair - Rebuild APT metadata",
+			taskType:   common.TASK_TYPE_REPOSITORY_APT_REBUILD_METADATA,
+		},
+		repositoryDescription: "The APT hosted repository to rebuild metadata for.",
+	}
+}
+
+func NewRepositoryHelmRebuildMetadataTask() *RepositoryRebuildTask {
+	return &RepositoryRebuildTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Repair - Rebuild Helm metadata",
+			taskType:   common.TASK_TYPE_REPOSITORY_HELM_REBUILD_METADATA,
+		},
+		repositoryDescription: "The Helm hosted repository to rebuild metadata for.",
+	}
+}
+
+func NewRepositoryNpmRebuildMetadataTask() *RepositoryRebuildTask {
+	return &RepositoryRebuildTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Repair - Rebuild npm metadata",
+			taskType:   common.TASK_TYPE_REPOSITORY_NPM_REBUILD_METADATA,
+		},
+		repositoryDescription: "The npm hosted repository to rebuild metadata for.",
+	}
+}
+
+func NewRepositoryPypiRebuildMetadataTask() *RepositoryRebuildTask {
+	return &RepositoryRebuildTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Repair - Rebuild PyPI metadata",
+			taskType:   common.TASK_TYPE_REPOSITORY_PYPI_REBUILD_METADATA,
+		},
+		repositoryDescription: "The PyPI hosted repository to rebuild metadata for.",
+	}
+}
+
+func NewRepositoryRebuildIndexTask() *RepositoryRebuildTask {
+	return &RepositoryRebuildTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Repair - Rebuild repository search",
+			taskType:   common.TASK_TYPE_REPOSITORY_REBUILD_INDEX,
+		},
+		repositoryDescription: "The Repository to rebuild the search index for. Use `*` for all Repositories.",
+	}
+}
+
+func NewRepositoryRubyRebuildVersionsTask() *RepositoryRebuildTask {
+	return &RepositoryRebuildTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Repair - Rebuild RubyGems versions files",
+			taskType:   common.TASK_TYPE_REPOSITORY_RUBY_REBUILD_VERSIONS,
+		},
+		repositoryDescription: "The RubyGems hosted repository to rebuild versions files for.",
+	}
+}
+
+func NewRepositoryYumRebuildMetadataTask() *RepositoryRebuildTask {
+	return &RepositoryRebuildTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Repair - Rebuild Yum repository metadata (repodata)",
+			taskType:   common.TASK_TYPE_REPOSITORY_YUM_REBUILD_METADATA,
+		},
+		repositoryDescription: "The Yum hosted repository to rebuild metadata (repodata) for.",
+	}
+}
+
+// --------------------------------------------
+// Repository Rebuild Functions
+// --------------------------------------------
+func (f *RepositoryRebuildTask) DoCreateRequest(plan any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*common.TaskApiModel, *http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskRepositoryRebuildModel)
+
+	// Call API to Create
+	return taskService.CreateTask(ctx, planModel.ToApiCreateModel(f.Type(), version))
+}
+
+func (f *RepositoryRebuildTask) DoUpdateRequest(plan any, state any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskRepositoryRebuildModel)
+
+	// Cast to correct State Model Type
+	stateModel := (state).(model.TaskRepositoryRebuildModel)
+
+	// Call API to Update
+	return taskService.UpdateTask(ctx, stateModel.Id.ValueString(), planModel.ToApiUpdateModel(version))
+}
+
+func (f *RepositoryRebuildTask) PlanAsModel(ctx context.Context, plan tfsdk.Plan) (any, diag.Diagnostics) {
+	var planModel model.TaskRepositoryRebuildModel
+	return planModel, plan.Get(ctx, &planModel)
+}
+
+func (f *RepositoryRebuildTask) PropertiesSchema() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{
+		"repository_name": schema.ResourceRequiredStringWithLengthAtLeast(f.repositoryDescription, 1),
+	}
+}
+
+func (f *RepositoryRebuildTask) StateAsModel(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics) {
+	var stateModel model.TaskRepositoryRebuildModel
+	return stateModel, state.Get(ctx, &stateModel)
+}
+
+func (f *RepositoryRebuildTask) UpdatePlanForState(plan any) any {
+	var planModel = (plan).(model.TaskRepositoryRebuildModel)
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return planModel
+}
+
+func (f *RepositoryRebuildTask) UpdateStateFromApi(state any, api any) any {
+	stateModel := (state).(model.TaskRepositoryRebuildModel)
+	apiModel := (api).(common.TaskApiModel)
+	stateModel.MapFromApi(&apiModel)
+	return stateModel
+}
+
+func (f *RepositoryRebuildTask) UpdateStateFromPlanForUpdate(plan any, state any) any {
+	planModel := (plan).(model.TaskRepositoryRebuildModel)
+	stateModel := (state).(model.TaskRepositoryRebuildModel)
+
+	planModel.Id = stateModel.Id
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return planModel
+}
+
+// --------------------------------------------
+// Maven Repository Rebuild Metadata
+// --------------------------------------------
+type RepositoryMavenRebuildMetadataTask struct {
+	BaseTaskType
+}
+
+func NewRepositoryMavenRebuildMetadataTask() *RepositoryMavenRebuildMetadataTask {
+	return &RepositoryMavenRebuildMetadataTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Repair - Rebuild Maven repository metadata (maven-metadata.xml)",
+			taskType:   common.TASK_TYPE_REPOSITORY_MAVEN_REBUILD_METADATA,
+		},
+	}
+}
+
+// --------------------------------------------
+// Maven Repository Rebuild Metadata Functions
+// --------------------------------------------
+func (f *RepositoryMavenRebuildMetadataTask) DoCreateRequest(plan any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*common.TaskApiModel, *http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskRepositoryMavenRebuildMetadataModel)
+
+	// Call API to Create
+	return taskService.CreateTask(ctx, planModel.ToApiCreateModel(version))
+}
+
+func (f *RepositoryMavenRebuildMetadataTask) DoUpdateRequest(plan any, state any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskRepositoryMavenRebuildMetadataModel)
+
+	// Cast to correct State Model Type
+	stateModel := (state).(model.TaskRepositoryMavenRebuildMetadataModel)
+
+	// Call API to Update
+	return taskService.UpdateTask(ctx, stateModel.Id.ValueString(), planModel.ToApiUpdateModel(version))
+}
+
+func (f *RepositoryMavenRebuildMetadataTask) PlanAsModel(ctx context.Context, plan tfsdk.Plan) (any, diag.Diagnostics) {
+	var planModel model.TaskRepositoryMavenRebuildMetadataModel
+	return planModel, plan.Get(ctx, &planModel)
+}
+
+func (f *RepositoryMavenRebuildMetadataTask) PropertiesSchema() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{
+		"repository_name": schema.ResourceRequiredStringWithLengthAtLeast(
+			"The Maven repository to rebuild metadata for. Use `*` for all Maven repositories.",
+			1,
+		),
+		"group_id": schema.ResourceOptionalStringWithValidators(
+			"Only rebuild metadata for components with this Group ID.",
+			stringvalidator.LengthAtLeast(1),
+		),
+		"artifact_id": schema.ResourceOptionalStringWithValidators(
+			"Only rebuild metadata for components with this Artifact ID. Requires `group_id`.",
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("group_id")),
+		),
+		"base_version": schema.ResourceOptionalStringWithValidators(
+			"Only rebuild metadata for components with this Base Version. Requires `artifact_id`.",
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.AlsoRequires(path.MatchRelative().AtParent().AtName("artifact_id")),
+		),
+		"rebuild_checksums": schema.ResourceOptionalBoolWithDefault(
+			"Whether to also rebuild missing or incorrect checksums.",
+			common.TASK_REPOSITORY_MAVEN_REBUILD_METADATA_DEFAULT_REBUILD_CHECKSUMS,
+		),
+	}
+}
+
+func (f *RepositoryMavenRebuildMetadataTask) StateAsModel(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics) {
+	var stateModel model.TaskRepositoryMavenRebuildMetadataModel
+	return stateModel, state.Get(ctx, &stateModel)
+}
+
+func (f *RepositoryMavenRebuildMetadataTask) UpdatePlanForState(plan any) any {
+	var planModel = (plan).(model.TaskRepositoryMavenRebuildMetadataModel)
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return planModel
+}
+
+func (f *RepositoryMavenRebuildMetadataTask) UpdateStateFromApi(state any, api any) any {
+	stateModel := (state).(model.TaskRepositoryMavenRebuildMetadataModel)
+	apiModel := (api).(common.TaskApiModel)
+	stateModel.MapFromApi(&apiModel)
+	return stateModel
+}
+
+func (f *RepositoryMavenRebuildMetadataTask) UpdateStateFromPlanForUpdate(plan any, state any) any {
+	planModel := (plan).(model.TaskRepositoryMavenRebuildMetadataModel)
+	stateModel := (state).(model.TaskRepositoryMavenRebuildMetadataModel)
+
+	planModel.Id = stateModel.Id
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return planModel
+}
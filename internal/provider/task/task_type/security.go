@@ -0,0 +1,102 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasktype
+
+import (
+	"context"
+	"net/http"
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type SecurityPurgeApiKeysTask struct {
+	BaseTaskType
+}
+
+func NewSecurityPurgeApiKeysTask() *SecurityPurgeApiKeysTask {
+	return &SecurityPurgeApiKeysTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Admin - Delete orphaned API keys",
+			taskType:   common.TASK_TYPE_SECURITY_PURGE_API_KEYS,
+		},
+	}
+}
+
+// --------------------------------------------
+// Security Purge API Keys Functions
+// --------------------------------------------
+func (f *SecurityPurgeApiKeysTask) DoCreateRequest(plan any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*common.TaskApiModel, *http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskSecurityPurgeApiKeysModel)
+
+	// Call API to Create
+	return taskService.CreateTask(ctx, planModel.ToApiCreateModel())
+}
+
+func (f *SecurityPurgeApiKeysTask) DoUpdateRequest(plan any, state any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskSecurityPurgeApiKeysModel)
+
+	// Cast to correct State Model Type
+	stateModel := (state).(model.TaskSecurityPurgeApiKeysModel)
+
+	// Call API to Update
+	return taskService.UpdateTask(ctx, stateModel.Id.ValueString(), planModel.ToApiUpdateModel())
+}
+
+func (f *SecurityPurgeApiKeysTask) PlanAsModel(ctx context.Context, plan tfsdk.Plan) (any, diag.Diagnostics) {
+	var planModel model.TaskSecurityPurgeApiKeysModel
+	return planModel, plan.Get(ctx, &planModel)
+}
+
+func (f *SecurityPurgeApiKeysTask) PropertiesSchema() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{}
+}
+
+func (f *SecurityPurgeApiKeysTask) StateAsModel(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics) {
+	var stateModel model.TaskSecurityPurgeApiKeysModel
+	return stateModel, state.Get(ctx, &stateModel)
+}
+
+func (f *SecurityPurgeApiKeysTask) UpdatePlanForState(plan any) any {
+	var planModel = (plan).(model.TaskSecurityPurgeApiKeysModel)
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return planModel
+}
+
+func (f *SecurityPurgeApiKeysTask) UpdateStateFromApi(state, api any) any {
+	stateModel := (state).(model.TaskSecurityPurgeApiKeysModel)
+	apiModel := (api).(common.TaskApiModel)
+	stateModel.MapFromApi(&apiModel)
+	return stateModel
+}
+
+func (f *SecurityPurgeApiKeysTask) UpdateStateFromPlanForUpdate(plan, state any) any {
+	planModel := (plan).(model.TaskSecurityPurgeApiKeysModel)
+	stateModel := (state).(model.TaskSecurityPurgeApiKeysModel)
+
+	planModel.Id = stateModel.Id
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return planModel
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package tasktype
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"terraform-provider-sonatyperepo/internal/provider/common"
+	"terraform-provider-sonatyperepo/internal/provider/model"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	tfschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/sonatype-nexus-community/terraform-provider-shared/schema"
+)
+
+type TagsCleanupTask struct {
+	BaseTaskType
+}
+
+func NewTagsCleanupTask() *TagsCleanupTask {
+	return &TagsCleanupTask{
+		BaseTaskType: BaseTaskType{
+			publicName: "Admin - Cleanup tags",
+			taskType:   common.TASK_TYPE_TAGS_CLEANUP,
+		},
+	}
+}
+
+// --------------------------------------------
+// Tags Cleanup Functions
+// --------------------------------------------
+func (f *TagsCleanupTask) DoCreateRequest(plan any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*common.TaskApiModel, *http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskTagsCleanupModel)
+
+	// Call API to Create
+	return taskService.CreateTask(ctx, planModel.ToApiCreateModel(version))
+}
+
+func (f *TagsCleanupTask) DoUpdateRequest(plan any, state any, taskService common.TaskService, ctx context.Context, version common.SystemVersion) (*http.Response, error) {
+	// Cast to correct Plan Model Type
+	planModel := (plan).(model.TaskTagsCleanupModel)
+
+	// Cast to correct State Model Type
+	stateModel := (state).(model.TaskTagsCleanupModel)
+
+	// Call API to Update
+	return taskService.UpdateTask(ctx, stateModel.Id.ValueString(), planModel.ToApiUpdateModel(version))
+}
+
+func (f *TagsCleanupTask) MarkdownDescription() string {
+	return fmt.Sprintf(
+		`Manage Task '%s' (%s)
+
+This task requires Sonatype Nexus Repository Pro.`,
+		f.PublicName(), f.Type().String(),
+	)
+}
+
+func (f *TagsCleanupTask) PlanAsModel(ctx context.Context, plan tfsdk.Plan) (any, diag.Diagnostics) {
+	var planModel model.TaskTagsCleanupModel
+	return planModel, plan.Get(ctx, &planModel)
+}
+
+func (f *TagsCleanupTask) PropertiesSchema() map[string]tfschema.Attribute {
+	return map[string]tfschema.Attribute{
+		"repository_name": schema.ResourceOptionalStringWithDefault(
+			"Only clean up tags associated with components in this Repository. Use `*` for all Repositories.",
+			common.TASK_TAGS_CLEANUP_ALL_REPOSITORIES,
+		),
+		"older_than": schema.ResourceOptionalInt32WithValidator(
+			"Delete tags that were last updated more than this many days ago. At least one of `older_than` or `tag_name_pattern` is required.",
+			int32validator.AtLeast(1),
+			int32validator.AtLeastOneOf(path.MatchRelative().AtParent().AtName("tag_name_pattern")),
+		),
+		"tag_name_pattern": schema.ResourceOptionalStringWithValidators(
+			"Delete tags whose name matches this regular expression. At least one of `older_than` or `tag_name_pattern` is required.",
+			stringvalidator.LengthAtLeast(1),
+		),
+		"delete_associated_components": schema.ResourceOptionalBoolWithDefault(
+			"Also delete the components associated with each deleted tag.",
+			common.TASK_TAGS_CLEANUP_DEFAULT_DELETE_ASSOCIATED_COMPONENTS,
+		),
+	}
+}
+
+func (f *TagsCleanupTask) StateAsModel(ctx context.Context, state tfsdk.State) (any, diag.Diagnostics) {
+	var stateModel model.TaskTagsCleanupModel
+	return stateModel, state.Get(ctx, &stateModel)
+}
+
+func (f *TagsCleanupTask) UpdatePlanForState(plan any) any {
+	var planModel = (plan).(model.TaskTagsCleanupModel)
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	return planModel
+}
+
+func (f *TagsCleanupTask) UpdateStateFromApi(state any, api any) any {
+	stateModel := (state).(model.TaskTagsCleanupModel)
+	apiModel := (api).(common.TaskApiModel)
+	stateModel.MapFromApi(&apiModel)
+	return stateModel
+}
+
+func (f *TagsCleanupTask) UpdateStateFromPlanForUpdate(plan any, state any) any {
+	planModel := (plan).(model.TaskTagsCleanupModel)
+	stateModel := (state).(model.TaskTagsCleanupModel)
+
+	planModel.Id = stateModel.Id
+	planModel.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	return planModel
+}
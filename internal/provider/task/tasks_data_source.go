@@ -54,15 +54,27 @@ func (d *tasksDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 // Schema defines the schema for the data source.
 func (d *tasksDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = tfschema.Schema{
-		Description: "Use this data source to get all Tasks",
+		Description: "Use this data source to get all Tasks, optionally filtered by type and current state",
 		Attributes: map[string]tfschema.Attribute{
+			"type": schema.DataSourceOptionalString("Only return Tasks of this type (e.g. `blobstore.compact`)."),
+			"current_state": schema.DataSourceOptionalStringEnum(
+				"Only return Tasks in this current state.",
+				common.TASK_CURRENT_STATE_WAITING,
+				common.TASK_CURRENT_STATE_RUNNING,
+				common.TASK_CURRENT_STATE_DONE,
+			),
 			"tasks": schema.DataSourceComputedListNestedAttribute(
 				"List of Tasks",
 				tfschema.NestedAttributeObject{
 					Attributes: map[string]tfschema.Attribute{
-						"id":   schema.DataSourceRequiredString("The ID of the Task."),
-						"name": schema.DataSourceRequiredString("The name of the Task."),
-						"type": schema.DataSourceRequiredString("The type of Task."),
+						"id":              schema.DataSourceRequiredString("The ID of the Task."),
+						"name":            schema.DataSourceRequiredString("The name of the Task."),
+						"type":            schema.DataSourceRequiredString("The type of Task."),
+						"current_state":   schema.DataSourceComputedString("The current state of the Task - `WAITING`, `RUNNING` or `DONE`."),
+						"last_run":        schema.DataSourceComputedString("When the Task last ran (RFC 3339), if it has run."),
+						"last_run_result": schema.DataSourceComputedString("The result of the last run of the Task (e.g. `OK` or `FAILED`), if it has run."),
+						"next_run":        schema.DataSourceComputedString("When the Task is next scheduled to run (RFC 3339), if it is scheduled."),
+						"message":         schema.DataSourceComputedString("Status message reported for the Task."),
 					},
 				},
 			),
@@ -74,6 +86,12 @@ func (d *tasksDataSource) Schema(_ context.Context, req datasource.SchemaRequest
 func (d *tasksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	var state model.TasksModel
 
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		tflog.Debug(ctx, fmt.Sprintf("Getting request data has errors: %v", resp.Diagnostics.Errors()))
+		return
+	}
+
 	ctx = d.AuthContext(ctx)
 
 	tasksResponse, httpResponse, err := d.Services.Task.ListTasks(ctx)
@@ -91,6 +109,9 @@ func (d *tasksDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	state.Tasks = make([]model.TaskModelSimple, 0)
 	for _, task := range tasksResponse {
+		if !state.Matches(&task) {
+			continue
+		}
 		tflog.Debug(ctx, fmt.Sprintf("    Processing %s Task", *task.Id))
 		taskModel := model.TaskModelSimple{}
 		taskModel.MapFromApi(&task)
@@ -51,6 +51,25 @@ func TestAccTasksDataSource(t *testing.T) {
 					resource.TestCheckResourceAttrSet(dataSourceTasks, "tasks.0.name"),
 				),
 			},
+			// Test 3: Filter by current state
+			{
+				Config: utils_test.ProviderConfig + `data "sonatyperepo_tasks" "tasks" {
+					current_state = "WAITING"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceTasks, "tasks.0.id"),
+					resource.TestCheckResourceAttr(dataSourceTasks, "tasks.0.current_state", "WAITING"),
+				),
+			},
+			// Test 4: Filter by a type no Task has
+			{
+				Config: utils_test.ProviderConfig + `data "sonatyperepo_tasks" "tasks" {
+					type = "not.a.task.type"
+				}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceTasks, "tasks.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -0,0 +1,75 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validators
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-sonatyperepo/internal/provider/csel"
+)
+
+// contentSelectorExpression validates that a string is a valid CSEL expression
+type contentSelectorExpression struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v contentSelectorExpression) Description(ctx context.Context) string {
+	return "value must be a valid Content Selector (CSEL) expression"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v contentSelectorExpression) MarkdownDescription(ctx context.Context) string {
+	return "value must be a valid Content Selector (CSEL) expression"
+}
+
+// ValidateString performs the validation.
+func (v contentSelectorExpression) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	expression := req.ConfigValue.ValueString()
+	err := csel.Validate(expression)
+	if err == nil {
+		return
+	}
+
+	detail := err.Error()
+	var syntaxError *csel.SyntaxError
+	if errors.As(err, &syntaxError) {
+		detail = fmt.Sprintf("%s\n\n%s", detail, pointAt(expression, syntaxError.Line, syntaxError.Column))
+	}
+	resp.Diagnostics.AddAttributeError(req.Path, "Invalid Content Selector expression", detail)
+}
+
+// pointAt returns the given line of the expression, with a marker under the given column.
+func pointAt(expression string, line, column int) string {
+	lines := strings.Split(expression, "\n")
+	if line < 1 || line > len(lines) {
+		return expression
+	}
+	return fmt.Sprintf("  %s\n  %s^", lines[line-1], strings.Repeat(" ", column-1))
+}
+
+// ContentSelectorExpression returns a validator that ensures a string is a valid CSEL expression
+func ContentSelectorExpression() validator.String {
+	return contentSelectorExpression{}
+}
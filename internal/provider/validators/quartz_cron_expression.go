@@ -0,0 +1,58 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+
+	"terraform-provider-sonatyperepo/internal/provider/quartz"
+)
+
+// quartzCronExpression validates that a string is a valid Quartz cron expression
+type quartzCronExpression struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v quartzCronExpression) Description(ctx context.Context) string {
+	return "value must be a valid Quartz cron expression"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v quartzCronExpression) MarkdownDescription(ctx context.Context) string {
+	return "value must be a valid Quartz cron expression"
+}
+
+// ValidateString performs the validation.
+func (v quartzCronExpression) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if err := quartz.Validate(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid cron expression",
+			"Sonatype Nexus Repository expects a Quartz cron expression (seconds minutes hours day-of-month month day-of-week [year]), e.g. `0 0 1 * * ?` to run at 01:00 every day: "+err.Error(),
+		)
+	}
+}
+
+// QuartzCronExpression returns a validator that ensures a string is a valid Quartz cron expression
+func QuartzCronExpression() validator.String {
+	return quartzCronExpression{}
+}
@@ -0,0 +1,146 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validators
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-sonatyperepo/internal/provider/common"
+)
+
+// Any start_date below this (early 1973 in milliseconds, or the year 5138 in seconds) has been given
+// in seconds
+const taskStartDateMinimumMilliseconds int64 = 100_000_000_000
+
+// taskFrequency validates that the attributes of a Task frequency make sense for its schedule
+type taskFrequency struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v taskFrequency) Description(ctx context.Context) string {
+	return "frequency attributes must apply to the schedule"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v taskFrequency) MarkdownDescription(ctx context.Context) string {
+	return "frequency attributes must apply to the `schedule`"
+}
+
+// ValidateObject performs the validation.
+func (v taskFrequency) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	data := req.ConfigValue.Attributes()
+
+	var schedule types.String
+	if !knownValueAs(ctx, data["schedule"], &schedule, &resp.Diagnostics) {
+		return
+	}
+
+	addError := func(attribute, detail string) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path.AtName(attribute),
+			"Invalid Attribute Combination",
+			detail,
+		)
+	}
+
+	// start_date
+	var startDate types.Int64
+	if knownValueAs(ctx, data["start_date"], &startDate, &resp.Diagnostics) {
+		switch {
+		case schedule.ValueString() == common.FREQUENCY_SCHEDULE_MANUAL:
+			addError("start_date", "start_date does not apply to a \"manual\" schedule.")
+		case startDate.ValueInt64() < taskStartDateMinimumMilliseconds:
+			addError("start_date", fmt.Sprintf("start_date %d looks like a unix timestamp in seconds - Sonatype Nexus Repository expects milliseconds (e.g. %d).", startDate.ValueInt64(), startDate.ValueInt64()*1000))
+		}
+	} else if schedule.ValueString() == common.FREQUENCY_SCHEDULE_ONCE && isNull(data["start_date"]) {
+		addError("start_date", "start_date is required for a \"once\" schedule.")
+	}
+
+	// cron_expression
+	cronExpressionSet := !isNull(data["cron_expression"])
+	if schedule.ValueString() == common.FREQUENCY_SCHEDULE_CRON && !cronExpressionSet {
+		addError("cron_expression", "cron_expression is required for a \"cron\" schedule.")
+	} else if schedule.ValueString() != common.FREQUENCY_SCHEDULE_CRON && cronExpressionSet {
+		addError("cron_expression", fmt.Sprintf("cron_expression only applies to a \"cron\" schedule, not %q.", schedule.ValueString()))
+	}
+
+	// recurring_days
+	var maxDay int32
+	switch schedule.ValueString() {
+	case common.FREQUENCY_SCHEDULE_WEEKLY:
+		maxDay = 7
+	case common.FREQUENCY_SCHEDULE_MONTHLY:
+		maxDay = 31
+	}
+	if maxDay == 0 {
+		if !isNull(data["recurring_days"]) {
+			addError("recurring_days", fmt.Sprintf("recurring_days only applies to a \"weekly\" or \"monthly\" schedule, not %q.", schedule.ValueString()))
+		}
+		return
+	}
+	if isNull(data["recurring_days"]) {
+		addError("recurring_days", fmt.Sprintf("recurring_days is required for a %q schedule.", schedule.ValueString()))
+		return
+	}
+	var recurringDays []types.Int32
+	if !knownValueAs(ctx, data["recurring_days"], &recurringDays, &resp.Diagnostics) {
+		return
+	}
+	for i, rd := range recurringDays {
+		if rd.IsUnknown() || rd.IsNull() {
+			continue
+		}
+		if rd.ValueInt32() < 1 || rd.ValueInt32() > maxDay {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtName("recurring_days").AtListIndex(i),
+				"Invalid Attribute Value",
+				fmt.Sprintf("recurring_days for a %q schedule must be between 1 and %d, got: %d.", schedule.ValueString(), maxDay, rd.ValueInt32()),
+			)
+		}
+	}
+}
+
+// isNull is true when the attribute is not set - unknown values are not null.
+func isNull(value attr.Value) bool {
+	return value == nil || value.IsNull()
+}
+
+// knownValueAs converts the attribute into target, returning false if it is null or not yet known.
+func knownValueAs(ctx context.Context, value attr.Value, target any, diags *diag.Diagnostics) bool {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return false
+	}
+	if d := tfsdk.ValueAs(ctx, value, target); d.HasError() {
+		diags.Append(d...)
+		return false
+	}
+	return true
+}
+
+// TaskFrequency returns a validator that ensures the attributes of a Task frequency apply to its schedule
+func TaskFrequency() validator.Object {
+	return taskFrequency{}
+}
@@ -0,0 +1,59 @@
+/*
+ * Copyright (c) 2019-present Sonatype, Inc.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// timeZoneName validates that a string is an IANA Time Zone name
+type timeZoneName struct{}
+
+// Description returns a plain text description of the validator's behavior.
+func (v timeZoneName) Description(ctx context.Context) string {
+	return "value must be an IANA Time Zone name, e.g. Europe/London"
+}
+
+// MarkdownDescription returns a markdown formatted description of the validator's behavior.
+func (v timeZoneName) MarkdownDescription(ctx context.Context) string {
+	return "value must be an IANA Time Zone name, e.g. `Europe/London`"
+}
+
+// ValidateString performs the validation.
+func (v timeZoneName) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	name := req.ConfigValue.ValueString()
+	if _, err := time.LoadLocation(name); err != nil || name == "" || name == "Local" {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Time Zone",
+			fmt.Sprintf("%q is not an IANA Time Zone name - use a name such as `Europe/London` or `America/New_York`, or UTC.", name),
+		)
+	}
+}
+
+// TimeZoneName returns a validator that ensures a string is an IANA Time Zone name
+func TimeZoneName() validator.String {
+	return timeZoneName{}
+}
@@ -22,6 +22,9 @@ import (
 	"log"
 	"terraform-provider-sonatyperepo/internal/provider"
 
+	// Embed the IANA Time Zone database, so that Task `frequency.timezone` works on hosts without one
+	_ "time/tzdata"
+
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 